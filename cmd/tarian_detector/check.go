@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/tarian"
+)
+
+// runCheck reports the kernel capabilities used by the detector and the probes predicted to attach
+// on this node, without loading the eBPF module. It exits with a non-zero status if no probe would attach.
+func runCheck() {
+	// the symbols are optional, probes are attached by name if kallsyms is not readable
+	ks, err := ebpf.LoadKernelSymbols(ebpf.KallsymsPath)
+	if err != nil {
+		ks = nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tSTATUS\tDETAIL")
+
+	for _, r := range tarian.Check(ks) {
+		status := "ok"
+		if !r.Ok {
+			status = "missing"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.Name, status, r.Detail)
+	}
+
+	w.Flush()
+	fmt.Println()
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	attachable := 0
+	fmt.Fprintln(w, "PROBE\tPREDICTED\tHOOKS\tREASON")
+
+	for _, p := range tarian.PredictProbes(ks) {
+		if p.Enabled {
+			attachable++
+		}
+
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", p.Name, p.Enabled, strings.Join(p.Hooks, ","), p.Reason)
+	}
+
+	w.Flush()
+
+	if attachable != 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, "no probe can be attached on this node")
+	os.Exit(1)
+}
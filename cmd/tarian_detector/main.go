@@ -4,44 +4,128 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/intelops/tarian-detector/pkg/detector"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
 	"github.com/intelops/tarian-detector/tarian"
 )
 
 // main is the entry point of the application. It sets up the necessary components
 // and starts the main event loop.
 func main() {
-	// Create a channel to listen for interrupt signals (Ctrl+C or SIGTERM)
-	stopper := make(chan os.Signal, 1)
-	signal.Notify(stopper, os.Interrupt, syscall.SIGTERM)
+	listProbes := flag.Bool("list-probes", false, "list the available probes with their attach status and exit")
+	btfPath := flag.String("btf", "", "path of the kernel BTF used when the kernel exposes none, e.g. a file from BTFHub")
+	btfDir := flag.String("btf-dir", "", "directory holding <kernel release>.btf files, searched when the kernel exposes no BTF")
+	pin := flag.Bool("pin", false, "pin the maps and links under "+ebpf.DefaultPinPath+" so that the ring buffers and the raw tracepoint and LSM programs survive a restart; kprobes and uprobes are detached while the detector restarts")
+	unpin := flag.Bool("unpin", false, "remove the maps and links pinned under "+ebpf.DefaultPinPath+" and exit")
+	capture := flag.String("capture", "kprobe", "hooks the syscalls are captured from: kprobe or tracepoint")
+	events := flag.String("enable", "", "comma separated optional events to capture, among "+strings.Join(tarian.OptionalEvents(), ", "))
+	tls := flag.String("tls", "", "comma separated executables or shared libraries whose TLS plaintext is captured, e.g. /usr/lib/libssl.so.3")
+	tlsMaxData := flag.Uint("tls-max-data", tarian.MaxTLSData, "plaintext bytes captured per TLS read or write")
+	var pf policyFlags
+	flag.StringVar(&pf.exec, "deny-exec", "", "comma separated executables whose execution is blocked through BPF LSM")
+	flag.StringVar(&pf.open, "deny-open", "", "comma separated files whose opening is blocked through BPF LSM")
+	flag.StringVar(&pf.connect, "deny-connect", "", "comma separated addresses, e.g. 10.0.0.1 or 10.0.0.1:443, whose connection is blocked through BPF LSM")
+	flag.BoolVar(&pf.audit, "audit", false, "report the operations matching the deny rules as allowed instead of blocking them")
+	cgroups := flag.String("cgroup", "", "comma separated cgroup v2 directories whose network events are captured by cgroup programs")
+	pods := flag.String("pod", "", "comma separated uids of the pods whose network events are captured by cgroup programs")
+	strict := flag.Bool("strict", false, "exit if any probe fails to attach instead of running with partial coverage")
+	flag.Parse()
+
+	// Report the capabilities of the node instead of running the detector
+	if flag.Arg(0) == "check" {
+		runCheck()
+		return
+	}
 
-	// Initialize and start the Kubernetes watcher
-	watcher, err := K8Watcher()
+	// Remove the objects pinned by previous runs, which detaches their probes, instead of running the detector
+	if *unpin {
+		if err := ebpf.Unpin(ebpf.DefaultPinPath); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("unpinned %s\n", ebpf.DefaultPinPath)
+		return
+	}
+
+	captureMode, err := tarian.ParseCaptureMode(*capture)
 	if err != nil {
-		log.Print(err)
-	} else {
-		watcher.Start()
+		log.Fatal(err)
+	}
+
+	policy, err := pf.policy()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var cgroupMonitor *tarian.CgroupMonitor
+	if len(*cgroups) != 0 || len(*pods) != 0 {
+		cgroupMonitor = tarian.NewCgroupMonitor()
 	}
 
+	var pinPath string
+	if *pin {
+		pinPath = ebpf.DefaultPinPath
+	}
+
+	// Create a channel to listen for interrupt signals (Ctrl+C or SIGTERM)
+	stopper := make(chan os.Signal, 1)
+	signal.Notify(stopper, os.Interrupt, syscall.SIGTERM)
+
 	// Initialize Tarian eBPF module
-	tarianEbpfModule, err := tarian.GetModule()
+	tarianEbpfModule, err := tarian.GetModule(tarian.ModuleOptions{
+		BTFPath:    *btfPath,
+		BTFDir:     *btfDir,
+		PinPath:    pinPath,
+		Capture:    captureMode,
+		Events:     splitList(*events),
+		TLSPaths:   splitList(*tls),
+		TLSMaxData: uint32(*tlsMaxData),
+		Policy:     policy,
+		Cgroups:    cgroupMonitor,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	// Prepare the Tarian detector by attaching eBPF programs and creating map readers
-	tarianDetector, err := tarianEbpfModule.Prepare()
+	tarianDetector, err := tarianEbpfModule.Prepare(ebpf.PrepareOptions{Strict: *strict})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Report the available probes and their attach status instead of running the detector
+	if *listProbes {
+		printProbes(tarian.ListProbes(tarianEbpfModule, tarianDetector))
+		tarianDetector.Close()
+		return
+	}
+
+	// Report the coverage of the detector on this node
+	logAttachReport(tarianDetector.Report())
+
+	// Attach the cgroup programs to the requested workloads, they are detached on shutdown
+	if cgroupMonitor != nil {
+		attachCgroups(cgroupMonitor, splitList(*cgroups), splitList(*pods))
+	}
+
+	// Initialize and start the Kubernetes watcher
+	watcher, err := K8Watcher()
+	if err != nil {
+		log.Print(err)
+	} else {
+		watcher.Start()
+	}
+
 	// Instantiate the event detectors
 	eventsDetector := detector.NewEventsDetector()
 
@@ -61,6 +145,10 @@ func main() {
 		<-stopper // Wait for an interrupt signal
 
 		eventsDetector.Close()
+		if cgroupMonitor != nil {
+			cgroupMonitor.Close()
+		}
+
 		log.Printf("Total records captured : %d\n", eventsDetector.GetTotalCount())
 		count := 1
 		for ky, vl := range eventsDetector.GetProbeCount() {
@@ -101,3 +189,55 @@ func main() {
 		time.Sleep(1 * time.Minute)
 	}
 }
+
+// printProbes writes the given probes and their attach status as a table to the standard output.
+func printProbes(probes []tarian.ProbeStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROBE\tENABLED\tATTACHED\tHOOKS\tREASON")
+
+	for _, p := range probes {
+		fmt.Fprintf(w, "%s\t%t\t%t\t%s\t%s\n", p.Name, p.Enabled, p.Attached, strings.Join(p.Hooks, ","), p.Reason)
+	}
+
+	w.Flush()
+}
+
+// logAttachReport logs a summary of the attach report followed by every program that is not attached.
+func logAttachReport(r *ebpf.AttachReport) {
+	log.Printf("probes: %s\n", r)
+
+	for _, w := range r.Warnings() {
+		log.Printf("probes: %s\n", w)
+	}
+
+	for _, pr := range r.Programs() {
+		if pr.Status == ebpf.Attached {
+			continue
+		}
+
+		log.Printf("%s %s: %s\n", pr.Hook, pr.Status, pr.Reason)
+	}
+}
+
+// attachCgroups attaches the cgroup programs to the given cgroups and to the cgroups of the given pods.
+// Workloads whose cgroup can not be found or attached to are logged and skipped.
+func attachCgroups(c *tarian.CgroupMonitor, paths, podUIDs []string) {
+	for _, uid := range podUIDs {
+		path, err := tarian.FindPodCgroup(tarian.DefaultCgroupRoot, uid)
+		if err != nil {
+			log.Print(err)
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	for _, path := range paths {
+		if err := c.Attach(path); err != nil {
+			log.Print(err)
+			continue
+		}
+
+		log.Printf("monitoring cgroup %s\n", path)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/intelops/tarian-detector/tarian"
+)
+
+// policyFlags holds the comma separated rules of the enforcement flags.
+type policyFlags struct {
+	exec    string // Paths of the executables whose execution is matched
+	open    string // Paths of the files whose opening is matched
+	connect string // Addresses, with an optional port, whose connection is matched
+	audit   bool   // Report the matched operations without blocking them
+}
+
+// policy returns the enforcement policy holding the rules of the flags, nil if there is none.
+func (f policyFlags) policy() (*tarian.Policy, error) {
+	if len(f.exec) == 0 && len(f.open) == 0 && len(f.connect) == 0 {
+		return nil, nil
+	}
+
+	action := tarian.Deny
+	if f.audit {
+		action = tarian.Audit
+	}
+
+	p := tarian.NewPolicy()
+	for _, path := range splitList(f.exec) {
+		if err := p.AddExecRule(path, action); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, path := range splitList(f.open) {
+		if err := p.AddOpenRule(path, action); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, addr := range splitList(f.connect) {
+		ip, port, err := parseAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.AddConnectRule(ip, port, action); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// splitList returns the elements of a comma separated list, none if it is empty.
+func splitList(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
+
+// parseAddress parses an IP address with an optional port, e.g. 10.0.0.1, 10.0.0.1:443 or [::1]:443.
+func parseAddress(addr string) (net.IP, uint16, error) {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip, 0, nil
+	}
+
+	host, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, 0, fmt.Errorf("invalid address %q", addr)
+	}
+
+	port, err := strconv.ParseUint(p, 10, 16)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid port %q", addr)
+	}
+
+	return ip, uint16(port), nil
+}
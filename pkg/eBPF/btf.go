@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cilium/ebpf/btf"
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var btfErr = err.New("ebpf.btf")
+
+// btfExtensions lists the file names tried for a kernel release in a BTF directory, as
+// produced by BTFHub once the archives are extracted.
+var btfExtensions = []string{".btf", ""}
+
+// LoadKernelTypes reads the BTF of a kernel stored at path, e.g. a file downloaded from BTFHub,
+// for the CO-RE relocations of kernels that do not expose /sys/kernel/btf/vmlinux.
+func LoadKernelTypes(path string) (*btf.Spec, error) {
+	spec, err := btf.LoadSpec(path)
+	if err != nil {
+		return nil, btfErr.Throwf("%s: %v", path, err)
+	}
+
+	return spec, nil
+}
+
+// FindKernelTypes returns the path of the BTF file of the given kernel release in dir,
+// named either <release>.btf or <release>.
+func FindKernelTypes(dir, release string) (string, error) {
+	if len(release) == 0 {
+		return "", btfErr.Throw("empty kernel release")
+	}
+
+	for _, ext := range btfExtensions {
+		path := filepath.Join(dir, release+ext)
+
+		fi, err := os.Stat(path)
+		if err == nil && fi.Mode().IsRegular() {
+			return path, nil
+		}
+	}
+
+	return "", btfErr.Throwf("no BTF file for kernel %s in %s", release, dir)
+}
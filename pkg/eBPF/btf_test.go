@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestFindKernelTypes tests the FindKernelTypes function
+func TestFindKernelTypes(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"5.4.0-1012-aws.btf", "4.18.0-305.el8.x86_64"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "5.15.0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		release string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "btf extension",
+			release: "5.4.0-1012-aws",
+			want:    filepath.Join(dir, "5.4.0-1012-aws.btf"),
+		},
+		{
+			name:    "release name",
+			release: "4.18.0-305.el8.x86_64",
+			want:    filepath.Join(dir, "4.18.0-305.el8.x86_64"),
+		},
+		{
+			name:    "directory",
+			release: "5.15.0",
+			wantErr: true,
+		},
+		{
+			name:    "missing release",
+			release: "6.1.0",
+			wantErr: true,
+		},
+		{
+			name:    "empty release",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindKernelTypes(dir, tt.release)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindKernelTypes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("FindKernelTypes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLoadKernelTypes tests the LoadKernelTypes function
+func TestLoadKernelTypes(t *testing.T) {
+	invalid := filepath.Join(t.TempDir(), "invalid.btf")
+	if err := os.WriteFile(invalid, []byte("not btf"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "missing file",
+			path:    filepath.Join(t.TempDir(), "missing.btf"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid file",
+			path:    invalid,
+			wantErr: true,
+		},
+	}
+
+	if _, err := os.Stat("/sys/kernel/btf/vmlinux"); err == nil {
+		tests = append(tests, struct {
+			name    string
+			path    string
+			wantErr bool
+		}{name: "vmlinux", path: "/sys/kernel/btf/vmlinux"})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadKernelTypes(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadKernelTypes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got == nil {
+				t.Errorf("LoadKernelTypes() = nil, want spec")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
+	"github.com/cilium/ebpf/features"
+	"github.com/cilium/ebpf/link"
+)
+
+// kprobeMultiProbeSymbol is the kernel symbol used to probe the support of kprobe multi links.
+const kprobeMultiProbeSymbol = "vprintk"
+
+// HaveRingBuf reports whether the running kernel supports ring buffer maps (kernel >= 5.8).
+// The support is probed by creating a map rather than derived from the kernel version,
+// as distributions backport features to older kernels.
+func HaveRingBuf() bool {
+	return features.HaveMapType(ebpf.RingBuf) == nil
+}
+
+// HaveBTF returns nil if the running kernel exposes its BTF, which CO-RE relocations require.
+func HaveBTF() error {
+	_, err := btf.LoadKernelSpec()
+	return err
+}
+
+// HaveBpfLoop returns nil if kprobe programs can call the bpf_loop helper (kernel >= 5.17).
+func HaveBpfLoop() error {
+	return features.HaveProgramHelper(ebpf.Kprobe, asm.FnLoop)
+}
+
+// HaveKprobeMulti returns nil if the running kernel can attach a program to several
+// functions through a single kprobe multi link (kernel >= 5.18).
+func HaveKprobeMulti() error {
+	prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+		Type:       ebpf.Kprobe,
+		AttachType: ebpf.AttachTraceKprobeMulti,
+		License:    "MIT",
+		Instructions: asm.Instructions{
+			asm.Mov.Imm(asm.R0, 0),
+			asm.Return(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer prog.Close()
+
+	l, err := link.KprobeMulti(prog, link.KprobeMultiOptions{
+		Symbols: []string{kprobeMultiProbeSymbol},
+	})
+	if err != nil {
+		return err
+	}
+
+	return l.Close()
+}
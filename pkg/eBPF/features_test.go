@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/features"
+)
+
+// TestHaveRingBuf tests that HaveRingBuf agrees with the map type probe
+func TestHaveRingBuf(t *testing.T) {
+	want := features.HaveMapType(ebpf.RingBuf) == nil
+	if got := HaveRingBuf(); got != want {
+		t.Errorf("HaveRingBuf() = %v, want %v", got, want)
+	}
+}
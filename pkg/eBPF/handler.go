@@ -15,6 +15,8 @@ type Handler struct {
 	name       string      // Name of the handler
 	mapReaders []any       // List of map readers
 	probeLinks []link.Link // List of probe links
+
+	report AttachReport // Outcome of attaching the programs of the module
 }
 
 // NewHandler creates a new eBPF handler with the given name.
@@ -31,6 +33,39 @@ func (h *Handler) AddProbeLink(l link.Link) {
 	h.probeLinks = append(h.probeLinks, l)
 }
 
+// AddAttachedProgram adds the probe link of the given program to the handler and reports the program as attached.
+func (h *Handler) AddAttachedProgram(p *ProgramInfo, l link.Link) {
+	h.addAttachedProgram(p, l, "")
+}
+
+// addAttachedProgram adds the probe link of the given program to the handler and reports the program
+// as attached, with a note on how it was attached.
+func (h *Handler) addAttachedProgram(p *ProgramInfo, l link.Link, note string) {
+	h.report.add(p, Attached, note)
+	h.AddProbeLink(l)
+}
+
+// AddSkippedProgram reports the given program as skipped for the given reason.
+func (h *Handler) AddSkippedProgram(p *ProgramInfo, reason string) {
+	h.report.add(p, Skipped, reason)
+}
+
+// AddFailedProgram reports the given program as failed to attach for the given reason.
+func (h *Handler) AddFailedProgram(p *ProgramInfo, reason string) {
+	h.report.add(p, Failed, reason)
+}
+
+// IsAttached reports whether the given program was attached by the handler.
+func (h *Handler) IsAttached(p *ProgramInfo) bool {
+	pr, ok := h.report.Lookup(p)
+	return ok && pr.Status == Attached
+}
+
+// Report returns the outcome of attaching the programs of the module.
+func (h *Handler) Report() *AttachReport {
+	return &h.report
+}
+
 // AddMapReaders adds map readers to the handler.
 func (h *Handler) AddMapReaders(mrs []any) {
 	h.mapReaders = append(h.mapReaders, mrs...)
@@ -46,7 +81,7 @@ func (h *Handler) Count() int {
 	return len(h.probeLinks)
 }
 
-// Close detaches probes and closes map readers.
+// Close detaches the probes, except the pinned ones which stay attached, and closes map readers.
 func (h *Handler) Close() error {
 	if err := detachProbes(h.probeLinks); err != nil {
 		return handlerErr.Throwf("%v", err)
@@ -287,3 +287,108 @@ func TestHandler_Close(t *testing.T) {
 		})
 	}
 }
+
+// TestHandler_IsAttached tests the AddAttachedProgram and IsAttached functions
+func TestHandler_IsAttached(t *testing.T) {
+	attached := NewProgram(nil, NewHookInfo().Kprobe("vprintk"))
+	detached := NewProgram(nil, NewHookInfo().Kretprobe("vprintk"))
+
+	h := NewHandler("test")
+	h.AddAttachedProgram(attached, nil)
+
+	tests := []struct {
+		name string
+		prog *ProgramInfo
+		want bool
+	}{
+		{
+			name: "attached program",
+			prog: attached,
+			want: true,
+		},
+		{
+			name: "detached program",
+			prog: detached,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.IsAttached(tt.prog); got != tt.want {
+				t.Errorf("Handler.IsAttached() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if h.Count() != 1 {
+		t.Errorf("Handler.Count() = %v, want %v", h.Count(), 1)
+	}
+}
+
+// TestHandler_Report tests the AddSkippedProgram, AddFailedProgram and Report functions
+func TestHandler_Report(t *testing.T) {
+	attached := NewProgram(nil, NewHookInfo().Kprobe("vprintk"))
+	skipped := NewProgram(nil, NewHookInfo().Kprobe("__x64_sys_openat2"))
+	failed := NewProgram(nil, NewHookInfo().Kprobe(""))
+	missing := NewProgram(nil, NewHookInfo().Kretprobe("vprintk"))
+
+	h := NewHandler("test")
+	h.AddAttachedProgram(attached, nil)
+	h.AddSkippedProgram(skipped, "symbol missing")
+	h.AddFailedProgram(failed, "missing name")
+
+	tests := []struct {
+		name       string
+		prog       *ProgramInfo
+		wantStatus AttachStatus
+		wantReason string
+		wantOk     bool
+	}{
+		{
+			name:       "attached program",
+			prog:       attached,
+			wantStatus: Attached,
+			wantOk:     true,
+		},
+		{
+			name:       "skipped program",
+			prog:       skipped,
+			wantStatus: Skipped,
+			wantReason: "symbol missing",
+			wantOk:     true,
+		},
+		{
+			name:       "failed program",
+			prog:       failed,
+			wantStatus: Failed,
+			wantReason: "missing name",
+			wantOk:     true,
+		},
+		{
+			name: "program missing from the report",
+			prog: missing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := h.Report().Lookup(tt.prog)
+			if ok != tt.wantOk {
+				t.Fatalf("AttachReport.Lookup() ok = %v, want %v", ok, tt.wantOk)
+			}
+
+			if ok && (got.Status != tt.wantStatus || got.Reason != tt.wantReason) {
+				t.Errorf("AttachReport.Lookup() = %+v, want %v %q", got, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+
+	if got := h.Report().String(); got != "1 attached, 1 skipped, 1 failed" {
+		t.Errorf("AttachReport.String() = %v", got)
+	}
+
+	if h.Count() != 1 {
+		t.Errorf("Handler.Count() = %v, want %v", h.Count(), 1)
+	}
+}
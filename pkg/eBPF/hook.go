@@ -5,6 +5,7 @@ package ebpf
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/link"
@@ -20,8 +21,12 @@ type HookInfoType int
 type HookInfo struct {
 	hookType HookInfoType // Type of the eBPF hook
 	group    string       // Group name, required for Tracepoint type hooks
+	path     string       // Path of the executable, required for Uprobe and Uretprobe type hooks
 	name     string       // Name of the hook
 	opts     any          // Options for the hook, varies based on the hook type
+
+	fallbacks []string // Alternative kernel symbols of Kprobe and Kretprobe type hooks
+	symbol    string   // Kernel symbol resolved for Kprobe and Kretprobe type hooks
 }
 
 // Constants representing different types of eBPF hooks.
@@ -31,12 +36,20 @@ const (
 	Kprobe
 	Kretprobe
 	Cgroup
+	Fentry
+	Fexit
+	LSM
+	Uprobe
+	Uretprobe
+	KprobeMulti
+	KretprobeMulti
 )
 
 const (
 	ErrInvalidBpfHookType               string = "invalid BPF hook type: %v"
 	ErrMissingOptionsForBpfHookType     string = "missing field %s for the BPF Hook: %v"
 	ErrInvalidOptionsTypeForBpfHookType string = "unexpected 'Opts' field type detected in the BPF Hook. Expected type: %T, Received type: %T"
+	ErrUnavailableKernelSymbol          string = "none of the kernel symbols %v is available"
 )
 
 // NewHookInfo creates a new HookInfo instance with default values.
@@ -67,6 +80,7 @@ func (hi *HookInfo) Tracepoint(g string, n string, op ...*link.TracepointOptions
 // RawTracepoint sets the HookInfo instance to represent a RawTracepoint type hook.
 func (hi *HookInfo) RawTracepoint(op link.RawTracepointOptions) *HookInfo {
 	hi.hookType = RawTracepoint
+	hi.name = op.Name
 	hi.opts = op
 
 	return hi
@@ -100,10 +114,125 @@ func (hi *HookInfo) Kretprobe(n string, op ...*link.KprobeOptions) *HookInfo {
 	return hi
 }
 
+// Fallback sets the alternative kernel symbols of a Kprobe or Kretprobe type hook, tried in order
+// when the kernel does not export the symbol named by the hook. The alternatives must take the same
+// arguments as the symbol, since the program reads them the same way.
+func (hi *HookInfo) Fallback(names ...string) *HookInfo {
+	hi.fallbacks = names
+
+	return hi
+}
+
+// Resolve picks the first kernel symbol of a Kprobe or Kretprobe type hook exported by the kernel,
+// starting with the name of the hook and continuing with its fallbacks. It returns an error if none
+// of them is available. Other hook types, hooks without a name and a nil symbol table are left untouched.
+func (hi *HookInfo) Resolve(ks *KernelSymbols) error {
+	if ks == nil || len(hi.name) == 0 || (hi.hookType != Kprobe && hi.hookType != Kretprobe) {
+		return nil
+	}
+
+	candidates := append([]string{hi.name}, hi.fallbacks...)
+	symbol, ok := ks.Resolve(candidates...)
+	if !ok {
+		return hookErr.Throwf(ErrUnavailableKernelSymbol, candidates)
+	}
+
+	hi.symbol = symbol
+	return nil
+}
+
+// Fentry sets the HookInfo instance to represent a Fentry type hook on the kernel function n. The function
+// is the attach target of the program, set through ProgramSpec.AttachTo when loading it.
+func (hi *HookInfo) Fentry(n string, op ...link.TracingOptions) *HookInfo {
+	return hi.tracing(Fentry, n, op...)
+}
+
+// Fexit sets the HookInfo instance to represent a Fexit type hook on the kernel function n. The function
+// is the attach target of the program, set through ProgramSpec.AttachTo when loading it.
+func (hi *HookInfo) Fexit(n string, op ...link.TracingOptions) *HookInfo {
+	return hi.tracing(Fexit, n, op...)
+}
+
+// tracing sets the HookInfo instance to represent a Fentry or Fexit type hook.
+func (hi *HookInfo) tracing(t HookInfoType, n string, op ...link.TracingOptions) *HookInfo {
+	if len(op) > 0 {
+		hi.opts = op[0]
+	} else {
+		hi.opts = link.TracingOptions{}
+	}
+
+	hi.hookType = t
+	hi.name = n
+
+	return hi
+}
+
+// LSM sets the HookInfo instance to represent a LSM type hook on the security hook n, e.g. file_open.
+// The hook is the attach target of the program, set through ProgramSpec.AttachTo when loading it.
+func (hi *HookInfo) LSM(n string, op ...link.LSMOptions) *HookInfo {
+	if len(op) > 0 {
+		hi.opts = op[0]
+	} else {
+		hi.opts = link.LSMOptions{}
+	}
+
+	hi.hookType = LSM
+	hi.name = n
+
+	return hi
+}
+
+// Uprobe sets the HookInfo instance to represent a Uprobe type hook on the symbol n of the executable at path.
+func (hi *HookInfo) Uprobe(path string, n string, op ...*link.UprobeOptions) *HookInfo {
+	return hi.uprobe(Uprobe, path, n, op...)
+}
+
+// Uretprobe sets the HookInfo instance to represent a Uretprobe type hook on the symbol n of the executable at path.
+func (hi *HookInfo) Uretprobe(path string, n string, op ...*link.UprobeOptions) *HookInfo {
+	return hi.uprobe(Uretprobe, path, n, op...)
+}
+
+// uprobe sets the HookInfo instance to represent a Uprobe or Uretprobe type hook.
+func (hi *HookInfo) uprobe(t HookInfoType, path string, n string, op ...*link.UprobeOptions) *HookInfo {
+	if len(op) > 0 {
+		hi.opts = op[0]
+	} else {
+		hi.opts = &link.UprobeOptions{}
+	}
+
+	hi.hookType = t
+	hi.path = path
+	hi.name = n
+
+	return hi
+}
+
+// KprobeMulti sets the HookInfo instance to represent a KprobeMulti type hook, attaching the program
+// to all the kernel symbols or addresses of the options through a single link (kernel >= 5.18).
+func (hi *HookInfo) KprobeMulti(op link.KprobeMultiOptions) *HookInfo {
+	hi.hookType = KprobeMulti
+	hi.name = strings.Join(op.Symbols, ",")
+	hi.opts = op
+
+	return hi
+}
+
+// KretprobeMulti sets the HookInfo instance to represent a KretprobeMulti type hook, attaching the program
+// to the return of all the kernel symbols or addresses of the options through a single link (kernel >= 5.18).
+func (hi *HookInfo) KretprobeMulti(op link.KprobeMultiOptions) *HookInfo {
+	hi.hookType = KretprobeMulti
+	hi.name = strings.Join(op.Symbols, ",")
+	hi.opts = op
+
+	return hi
+}
+
 // Cgroup sets the HookInfo instance to represent a Cgroup type hook.
 func (hi *HookInfo) Cgroup(op link.CgroupOptions) *HookInfo {
 	hi.hookType = Cgroup
 	hi.opts = op
+	hi.path = op.Path
+	hi.name = op.Attach.String()
 
 	return hi
 }
@@ -132,6 +261,15 @@ func (hi *HookInfo) AttachProbe(programName *ebpf.Program) (link.Link, error) {
 			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, link.RawTracepointOptions{}, hi.opts)
 		}
 
+		if len(opts.Name) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Name'", hi.hookType)
+		}
+
+		// the program of the module is attached unless the options name another one
+		if opts.Program == nil {
+			opts.Program = programName
+		}
+
 		return link.AttachRawTracepoint(opts)
 	case Kprobe, Kretprobe:
 		if len(hi.name) == 0 {
@@ -144,9 +282,9 @@ func (hi *HookInfo) AttachProbe(programName *ebpf.Program) (link.Link, error) {
 		}
 
 		if hi.hookType == Kprobe {
-			return link.Kprobe(hi.name, programName, opts)
+			return link.Kprobe(hi.GetSymbol(), programName, opts)
 		} else {
-			return link.Kretprobe(hi.name, programName, opts)
+			return link.Kretprobe(hi.GetSymbol(), programName, opts)
 		}
 	case Cgroup:
 		opts, ok := hi.opts.(link.CgroupOptions)
@@ -154,7 +292,85 @@ func (hi *HookInfo) AttachProbe(programName *ebpf.Program) (link.Link, error) {
 			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, link.CgroupOptions{}, hi.opts)
 		}
 
+		if len(opts.Path) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Path'", hi.hookType)
+		}
+
+		// the program of the module is attached unless the options name another one
+		if opts.Program == nil {
+			opts.Program = programName
+		}
+
 		return link.AttachCgroup(opts)
+	case Fentry, Fexit:
+		if len(hi.name) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Name'", hi.hookType)
+		}
+
+		opts, ok := hi.opts.(link.TracingOptions)
+		if !ok {
+			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, link.TracingOptions{}, hi.opts)
+		}
+
+		if opts.Program == nil {
+			opts.Program = programName
+		}
+
+		return link.AttachTracing(opts)
+	case LSM:
+		if len(hi.name) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Name'", hi.hookType)
+		}
+
+		opts, ok := hi.opts.(link.LSMOptions)
+		if !ok {
+			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, link.LSMOptions{}, hi.opts)
+		}
+
+		if opts.Program == nil {
+			opts.Program = programName
+		}
+
+		return link.AttachLSM(opts)
+	case Uprobe, Uretprobe:
+		if len(hi.path) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Path'", hi.hookType)
+		}
+
+		if len(hi.name) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Name'", hi.hookType)
+		}
+
+		opts, ok := hi.opts.(*link.UprobeOptions)
+		if !ok {
+			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, &link.UprobeOptions{}, hi.opts)
+		}
+
+		ex, err := link.OpenExecutable(hi.path)
+		if err != nil {
+			return nil, hookErr.Throwf("%v", err)
+		}
+
+		if hi.hookType == Uprobe {
+			return ex.Uprobe(hi.name, programName, opts)
+		}
+
+		return ex.Uretprobe(hi.name, programName, opts)
+	case KprobeMulti, KretprobeMulti:
+		opts, ok := hi.opts.(link.KprobeMultiOptions)
+		if !ok {
+			return nil, hookErr.Throwf(ErrInvalidOptionsTypeForBpfHookType, link.KprobeMultiOptions{}, hi.opts)
+		}
+
+		if len(opts.Symbols) == 0 && len(opts.Addresses) == 0 {
+			return nil, hookErr.Throwf(ErrMissingOptionsForBpfHookType, "'Symbols' or 'Addresses'", hi.hookType)
+		}
+
+		if hi.hookType == KprobeMulti {
+			return link.KprobeMulti(programName, opts)
+		}
+
+		return link.KretprobeMulti(programName, opts)
 	default:
 		return nil, hookErr.Throwf(ErrInvalidBpfHookType, hi.hookType)
 	}
@@ -187,16 +403,64 @@ func (hi *HookInfo) GetHookName() string {
 	return hi.name
 }
 
+// GetSymbol returns the kernel symbol a Kprobe or Kretprobe type hook attaches to, which is
+// the symbol picked by Resolve or the name of the hook if it was not resolved.
+func (hi *HookInfo) GetSymbol() string {
+	if len(hi.symbol) != 0 {
+		return hi.symbol
+	}
+
+	return hi.name
+}
+
 // GetHookGroup returns the group of the hook represented by the HookInfo instance.
 func (hi *HookInfo) GetHookGroup() string {
 	return hi.group
 }
 
+// GetPath returns the path of the executable of a Uprobe or Uretprobe type hook or the cgroup of a Cgroup type hook.
+func (hi *HookInfo) GetPath() string {
+	return hi.path
+}
+
 // GetOptions returns the opts of the hook represented by the HookInfo instance.
 func (hi *HookInfo) GetOptions() interface{} {
 	return hi.opts
 }
 
+// String returns a string representation of the hook, e.g. Kprobe/__x64_sys_execve.
+func (hi *HookInfo) String() string {
+	switch hi.hookType {
+	case Tracepoint:
+		return fmt.Sprintf("%s/%s/%s", hi.hookType, hi.group, hi.name)
+	case RawTracepoint, Fentry, Fexit, LSM:
+		return fmt.Sprintf("%s/%s", hi.hookType, hi.name)
+	case Uprobe, Uretprobe:
+		if opts, ok := hi.opts.(*link.UprobeOptions); ok && opts.Offset != 0 {
+			return fmt.Sprintf("%s/%s:%s+%#x", hi.hookType, hi.path, hi.name, opts.Offset)
+		}
+
+		return fmt.Sprintf("%s/%s:%s", hi.hookType, hi.path, hi.name)
+	case Cgroup:
+		if len(hi.path) == 0 {
+			return hi.hookType.String()
+		}
+
+		return fmt.Sprintf("%s/%s:%s", hi.hookType, hi.path, hi.name)
+	case KprobeMulti, KretprobeMulti:
+		opts, _ := hi.opts.(link.KprobeMultiOptions)
+		if len(opts.Symbols) == 0 {
+			return fmt.Sprintf("%s/%d addresses", hi.hookType, len(opts.Addresses))
+		}
+
+		return fmt.Sprintf("%s/%s", hi.hookType, hi.name)
+	case Kprobe, Kretprobe:
+		return fmt.Sprintf("%s/%s", hi.hookType, hi.GetSymbol())
+	default:
+		return hi.hookType.String()
+	}
+}
+
 // String method for the HookInfoType type. It returns a string representation of the HookInfoType.
 func (hit HookInfoType) String() string {
 	switch hit {
@@ -210,6 +474,20 @@ func (hit HookInfoType) String() string {
 		return "Kretprobe"
 	case Cgroup:
 		return "Cgroup"
+	case Fentry:
+		return "Fentry"
+	case Fexit:
+		return "Fexit"
+	case LSM:
+		return "LSM"
+	case Uprobe:
+		return "Uprobe"
+	case Uretprobe:
+		return "Uretprobe"
+	case KprobeMulti:
+		return "KprobeMulti"
+	case KretprobeMulti:
+		return "KretprobeMulti"
 	default:
 		return fmt.Sprintf("unknown HookInfoType(%d)", int(hit))
 	}
@@ -5,6 +5,7 @@ package ebpf
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cilium/ebpf"
@@ -58,6 +59,41 @@ func TestHookInfo(t *testing.T) {
 			hi:   NewHookInfo().Cgroup(link.CgroupOptions{}),
 			want: "Cgroup",
 		},
+		{
+			name: "Fentry",
+			hi:   NewHookInfo().Fentry("do_unlinkat"),
+			want: "Fentry",
+		},
+		{
+			name: "Fexit with options",
+			hi:   NewHookInfo().Fexit("do_unlinkat", link.TracingOptions{Cookie: 1}),
+			want: "Fexit",
+		},
+		{
+			name: "LSM",
+			hi:   NewHookInfo().LSM("file_open"),
+			want: "LSM",
+		},
+		{
+			name: "Uprobe",
+			hi:   NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write"),
+			want: "Uprobe",
+		},
+		{
+			name: "Uretprobe with options",
+			hi:   NewHookInfo().Uretprobe("/usr/lib/libssl.so.3", "SSL_read", &link.UprobeOptions{}),
+			want: "Uretprobe",
+		},
+		{
+			name: "KprobeMulti",
+			hi:   NewHookInfo().KprobeMulti(link.KprobeMultiOptions{Symbols: []string{"vfs_read", "vfs_write"}}),
+			want: "KprobeMulti",
+		},
+		{
+			name: "KretprobeMulti",
+			hi:   NewHookInfo().KretprobeMulti(link.KprobeMultiOptions{Symbols: []string{"vfs_read"}}),
+			want: "KretprobeMulti",
+		},
 	}
 
 	for _, tt := range tests {
@@ -118,6 +154,11 @@ func TestHookInfo_AttachProbe(t *testing.T) {
 			hi:      NewHookInfo().RawTracepoint(link.RawTracepointOptions{Name: "__x64_sys_printk", Program: prog}),
 			wantErr: true,
 		},
+		{
+			name:    "RawTracepoint with missing name",
+			hi:      NewHookInfo().RawTracepoint(link.RawTracepointOptions{}),
+			wantErr: true,
+		},
 		{
 			name:    "Kprobe with missing name",
 			hi:      NewHookInfo().Kprobe(""),
@@ -154,10 +195,86 @@ func TestHookInfo_AttachProbe(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "Cgroup",
+			name:    "Cgroup with missing path",
 			hi:      NewHookInfo().Cgroup(link.CgroupOptions{Path: "", Attach: 0, Program: prog}),
 			wantErr: true,
 		},
+		{
+			name:    "Fentry with missing name",
+			hi:      NewHookInfo().Fentry(""),
+			wantErr: true,
+		},
+		{
+			name: "Fexit with wrong options type",
+			hi: func() *HookInfo {
+				hi := NewHookInfo().Fexit("do_unlinkat")
+				hi.opts = &link.KprobeOptions{}
+				return hi
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "Fentry",
+			hi:      NewHookInfo().Fentry("do_unlinkat"),
+			wantErr: true,
+		},
+		{
+			name:    "LSM with missing name",
+			hi:      NewHookInfo().LSM(""),
+			wantErr: true,
+		},
+		{
+			name: "LSM with wrong options type",
+			hi: func() *HookInfo {
+				hi := NewHookInfo().LSM("file_open")
+				hi.opts = link.TracingOptions{}
+				return hi
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "LSM",
+			hi:      NewHookInfo().LSM("file_open"),
+			wantErr: true,
+		},
+		{
+			name:    "Uprobe with missing path",
+			hi:      NewHookInfo().Uprobe("", "SSL_write"),
+			wantErr: true,
+		},
+		{
+			name:    "Uretprobe with missing name",
+			hi:      NewHookInfo().Uretprobe("/usr/lib/libssl.so.3", ""),
+			wantErr: true,
+		},
+		{
+			name: "Uprobe with wrong options type",
+			hi: func() *HookInfo {
+				hi := NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write")
+				hi.opts = link.UprobeOptions{}
+				return hi
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "Uprobe with missing executable",
+			hi:      NewHookInfo().Uprobe("/nonexistent/libssl.so.3", "SSL_write"),
+			wantErr: true,
+		},
+		{
+			name:    "KprobeMulti with missing symbols",
+			hi:      NewHookInfo().KprobeMulti(link.KprobeMultiOptions{}),
+			wantErr: true,
+		},
+		{
+			name: "KretprobeMulti with wrong options type",
+			hi: func() *HookInfo {
+				hi := NewHookInfo().KretprobeMulti(link.KprobeMultiOptions{Symbols: []string{"vfs_read"}})
+				hi.opts = &link.KprobeOptions{}
+				return hi
+			}(),
+			wantErr: true,
+		},
 		{
 			name:    "Invalid HookInfoType",
 			hi:      &HookInfo{hookType: HookInfoType(999)}, // An unknown HookInfoType
@@ -197,6 +314,21 @@ func TestHookInfo_GetHookName(t *testing.T) {
 			hi:   NewHookInfo().Kretprobe("name"),
 			want: "name",
 		},
+		{
+			name: "RawTracepoint",
+			hi:   NewHookInfo().RawTracepoint(link.RawTracepointOptions{Name: "sys_enter"}),
+			want: "sys_enter",
+		},
+		{
+			name: "Uprobe",
+			hi:   NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write"),
+			want: "SSL_write",
+		},
+		{
+			name: "KprobeMulti",
+			hi:   NewHookInfo().KprobeMulti(link.KprobeMultiOptions{Symbols: []string{"vfs_read", "vfs_write"}}),
+			want: "vfs_read,vfs_write",
+		},
 	}
 
 	for _, tt := range tests {
@@ -241,6 +373,39 @@ func TestHookInfo_GetHookGroup(t *testing.T) {
 	}
 }
 
+// TestHookInfo_GetPath tests the GetPath function
+func TestHookInfo_GetPath(t *testing.T) {
+	tests := []struct {
+		name string
+		hi   *HookInfo
+		want string
+	}{
+		{
+			name: "Uprobe",
+			hi:   NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write"),
+			want: "/usr/lib/libssl.so.3",
+		},
+		{
+			name: "Cgroup",
+			hi:   NewHookInfo().Cgroup(link.CgroupOptions{Path: "/sys/fs/cgroup/pod"}),
+			want: "/sys/fs/cgroup/pod",
+		},
+		{
+			name: "Kprobe",
+			hi:   NewHookInfo().Kprobe("name"),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hi.GetPath(); got != tt.want {
+				t.Errorf("HookInfo.GetPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestHookInfo_GetOptions tests the GetOptions function
 func TestHookInfo_GetOptions(t *testing.T) {
 	tests := []struct {
@@ -273,6 +438,26 @@ func TestHookInfo_GetOptions(t *testing.T) {
 			hi:   NewHookInfo().Cgroup(link.CgroupOptions{}),
 			want: link.CgroupOptions{},
 		},
+		{
+			name: "Fentry",
+			hi:   NewHookInfo().Fentry("do_unlinkat"),
+			want: link.TracingOptions{},
+		},
+		{
+			name: "LSM",
+			hi:   NewHookInfo().LSM("file_open"),
+			want: link.LSMOptions{},
+		},
+		{
+			name: "Uprobe",
+			hi:   NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write"),
+			want: &link.UprobeOptions{},
+		},
+		{
+			name: "KprobeMulti",
+			hi:   NewHookInfo().KprobeMulti(link.KprobeMultiOptions{}),
+			want: link.KprobeMultiOptions{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -285,6 +470,145 @@ func TestHookInfo_GetOptions(t *testing.T) {
 	}
 }
 
+// TestHookInfo_String tests the String function
+func TestHookInfo_String(t *testing.T) {
+	tests := []struct {
+		name string
+		hi   *HookInfo
+		want string
+	}{
+		{
+			name: "Tracepoint",
+			hi:   NewHookInfo().Tracepoint("syscalls", "sys_enter_execve"),
+			want: "Tracepoint/syscalls/sys_enter_execve",
+		},
+		{
+			name: "RawTracepoint",
+			hi:   NewHookInfo().RawTracepoint(link.RawTracepointOptions{Name: "sys_enter"}),
+			want: "RawTracepoint/sys_enter",
+		},
+		{
+			name: "Fentry",
+			hi:   NewHookInfo().Fentry("do_unlinkat"),
+			want: "Fentry/do_unlinkat",
+		},
+		{
+			name: "LSM",
+			hi:   NewHookInfo().LSM("file_open"),
+			want: "LSM/file_open",
+		},
+		{
+			name: "Uretprobe",
+			hi:   NewHookInfo().Uretprobe("/usr/lib/libssl.so.3", "SSL_read"),
+			want: "Uretprobe//usr/lib/libssl.so.3:SSL_read",
+		},
+		{
+			name: "Uprobe with offset",
+			hi:   NewHookInfo().Uprobe("/usr/local/bin/server", "crypto/tls.(*Conn).Read", &link.UprobeOptions{Offset: 0x1a4}),
+			want: "Uprobe//usr/local/bin/server:crypto/tls.(*Conn).Read+0x1a4",
+		},
+		{
+			name: "KprobeMulti",
+			hi:   NewHookInfo().KprobeMulti(link.KprobeMultiOptions{Symbols: []string{"vfs_read", "vfs_write"}}),
+			want: "KprobeMulti/vfs_read,vfs_write",
+		},
+		{
+			name: "KretprobeMulti with addresses",
+			hi:   NewHookInfo().KretprobeMulti(link.KprobeMultiOptions{Addresses: []uintptr{0xffffffff81000000}}),
+			want: "KretprobeMulti/1 addresses",
+		},
+		{
+			name: "Kprobe",
+			hi:   NewHookInfo().Kprobe("__x64_sys_execve"),
+			want: "Kprobe/__x64_sys_execve",
+		},
+		{
+			name: "Kretprobe",
+			hi:   NewHookInfo().Kretprobe("__x64_sys_execve"),
+			want: "Kretprobe/__x64_sys_execve",
+		},
+		{
+			name: "Cgroup",
+			hi:   NewHookInfo().Cgroup(link.CgroupOptions{}),
+			want: "Cgroup",
+		},
+		{
+			name: "Cgroup with path",
+			hi:   NewHookInfo().Cgroup(link.CgroupOptions{Path: "/sys/fs/cgroup/pod", Attach: ebpf.AttachCGroupInet4Connect}),
+			want: "Cgroup//sys/fs/cgroup/pod:CGroupInet4Connect",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hi.String(); got != tt.want {
+				t.Errorf("HookInfo.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHookInfo_Resolve tests the Resolve and GetSymbol functions
+func TestHookInfo_Resolve(t *testing.T) {
+	ks, err := ParseKernelSymbols(strings.NewReader(testKallsyms))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		hi      *HookInfo
+		ks      *KernelSymbols
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "available symbol",
+			hi:   NewHookInfo().Kprobe("__x64_sys_execve").Fallback("sys_execve"),
+			ks:   ks,
+			want: "__x64_sys_execve",
+		},
+		{
+			name: "fallback symbol",
+			hi:   NewHookInfo().Kretprobe("__x64_sys_openat").Fallback("__se_sys_openat", "sys_openat"),
+			ks:   ks,
+			want: "__se_sys_openat",
+		},
+		{
+			name:    "missing symbol",
+			hi:      NewHookInfo().Kprobe("__x64_sys_openat2").Fallback("sys_openat2"),
+			ks:      ks,
+			want:    "__x64_sys_openat2",
+			wantErr: true,
+		},
+		{
+			name: "no symbol table",
+			hi:   NewHookInfo().Kprobe("__x64_sys_openat2"),
+			ks:   nil,
+			want: "__x64_sys_openat2",
+		},
+		{
+			name: "tracepoint",
+			hi:   NewHookInfo().Tracepoint("syscalls", "sys_enter_openat2"),
+			ks:   ks,
+			want: "sys_enter_openat2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hi.Resolve(tt.ks)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HookInfo.Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := tt.hi.GetSymbol(); got != tt.want {
+				t.Errorf("HookInfo.GetSymbol() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestHookInfoType_String tests the String function
 func TestHookInfoType_String(t *testing.T) {
 	tests := []struct {
@@ -317,6 +641,41 @@ func TestHookInfoType_String(t *testing.T) {
 			hit:  Cgroup,
 			want: "Cgroup",
 		},
+		{
+			name: "Fentry",
+			hit:  Fentry,
+			want: "Fentry",
+		},
+		{
+			name: "Fexit",
+			hit:  Fexit,
+			want: "Fexit",
+		},
+		{
+			name: "LSM",
+			hit:  LSM,
+			want: "LSM",
+		},
+		{
+			name: "Uprobe",
+			hit:  Uprobe,
+			want: "Uprobe",
+		},
+		{
+			name: "Uretprobe",
+			hit:  Uretprobe,
+			want: "Uretprobe",
+		},
+		{
+			name: "KprobeMulti",
+			hit:  KprobeMulti,
+			want: "KprobeMulti",
+		},
+		{
+			name: "KretprobeMulti",
+			hit:  KretprobeMulti,
+			want: "KretprobeMulti",
+		},
 		{
 			name: "Unknown",
 			hit:  HookInfoType(999), // An unknown HookInfoType
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var kallsymsErr = err.New("ebpf.kallsyms")
+
+// KallsymsPath is the path of the kernel symbol table.
+const KallsymsPath = "/proc/kallsyms"
+
+// KernelSymbols is the set of symbols exported by the running kernel and its modules.
+type KernelSymbols struct {
+	symbols map[string]bool // Names of the kernel symbols
+}
+
+// LoadKernelSymbols reads the kernel symbol table stored at path.
+func LoadKernelSymbols(path string) (*KernelSymbols, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, kallsymsErr.Throwf("%v", err)
+	}
+	defer f.Close()
+
+	return ParseKernelSymbols(f)
+}
+
+// ParseKernelSymbols parses a kernel symbol table in the /proc/kallsyms format,
+// e.g. "ffffffff8b8a3c10 T __x64_sys_execve".
+func ParseKernelSymbols(r io.Reader) (*KernelSymbols, error) {
+	ks := &KernelSymbols{
+		symbols: make(map[string]bool),
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		ks.symbols[fields[2]] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, kallsymsErr.Throwf("%v", err)
+	}
+
+	return ks, nil
+}
+
+// Has reports whether the kernel exports the named symbol.
+func (ks *KernelSymbols) Has(name string) bool {
+	return ks.symbols[name]
+}
+
+// Resolve returns the first of the candidate symbols exported by the kernel.
+// It returns false if none of them is available.
+func (ks *KernelSymbols) Resolve(candidates ...string) (string, bool) {
+	for _, c := range candidates {
+		if ks.Has(c) {
+			return c, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"strings"
+	"testing"
+)
+
+const testKallsyms = `ffffffff8b8a3c10 T __x64_sys_execve
+ffffffff8b8a3c80 T __se_sys_openat
+0000000000000000 t sys_close
+ffffffffc0a01000 t nf_conntrack_in	[nf_conntrack]
+malformed
+`
+
+// TestParseKernelSymbols tests the ParseKernelSymbols function
+func TestParseKernelSymbols(t *testing.T) {
+	ks, err := ParseKernelSymbols(strings.NewReader(testKallsyms))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		symbol string
+		want   bool
+	}{
+		{name: "text symbol", symbol: "__x64_sys_execve", want: true},
+		{name: "hidden address", symbol: "sys_close", want: true},
+		{name: "module symbol", symbol: "nf_conntrack_in", want: true},
+		{name: "module name", symbol: "[nf_conntrack]", want: false},
+		{name: "missing symbol", symbol: "__x64_sys_openat2", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ks.Has(tt.symbol); got != tt.want {
+				t.Errorf("KernelSymbols.Has() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestKernelSymbols_Resolve tests the Resolve function
+func TestKernelSymbols_Resolve(t *testing.T) {
+	ks, err := ParseKernelSymbols(strings.NewReader(testKallsyms))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		candidates []string
+		want       string
+		wantOk     bool
+	}{
+		{
+			name:       "first candidate",
+			candidates: []string{"__x64_sys_execve", "sys_execve"},
+			want:       "__x64_sys_execve",
+			wantOk:     true,
+		},
+		{
+			name:       "fallback candidate",
+			candidates: []string{"__x64_sys_openat", "__se_sys_openat", "sys_openat"},
+			want:       "__se_sys_openat",
+			wantOk:     true,
+		},
+		{
+			name:       "no candidate",
+			candidates: []string{"__x64_sys_openat2", "__se_sys_openat2", "sys_openat2"},
+			wantOk:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ks.Resolve(tt.candidates...)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("KernelSymbols.Resolve() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+// TestLoadKernelSymbols tests the LoadKernelSymbols function with a missing file
+func TestLoadKernelSymbols(t *testing.T) {
+	if _, err := LoadKernelSymbols("/nonexistent/kallsyms"); err == nil {
+		t.Errorf("LoadKernelSymbols() error = %v, wantErr %v", err, true)
+	}
+}
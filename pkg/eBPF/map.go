@@ -128,7 +128,25 @@ func (mi *MapInfo) CreateReaders() ([]any, error) {
 	}
 }
 
-// arrayOfMapsReader creates readers for an array of eBPF maps.
+// innerRingbufReader is a reader of a ring buffer found within an array of maps. The reader
+// does not own the map it reads from, so the inner map returned by the lookup is kept open
+// until the reader is closed.
+type innerRingbufReader struct {
+	*ringbuf.Reader
+	innerMap *ebpf.Map
+}
+
+// Close closes the reader and the inner map it reads from.
+func (r *innerRingbufReader) Close() error {
+	if err := r.Reader.Close(); err != nil {
+		return err
+	}
+
+	return r.innerMap.Close()
+}
+
+// arrayOfMapsReader creates readers for an array of eBPF maps. If a reader cannot be created,
+// the readers created so far are closed.
 func (mi *MapInfo) arrayOfMapsReader() ([]any, error) {
 	if mi.bpfMap == nil {
 		return nil, mapErr.Throw(ErrNilMapPointer)
@@ -136,37 +154,53 @@ func (mi *MapInfo) arrayOfMapsReader() ([]any, error) {
 
 	var arrMR []any
 	for i := uint32(0); i < mi.bpfMap.MaxEntries(); i++ {
-		var innerMap *ebpf.Map
-		var currMap MapInfo
-
-		if err := mi.bpfMap.Lookup(&i, &innerMap); err != nil {
-			return nil, mapErr.Throwf("%v", err)
+		mr, err := mi.innerMapReader(i)
+		if err != nil {
+			closeMapReaders(arrMR)
+			return nil, err
 		}
 
-		currMap.bpfMap = innerMap
-		currMap.mapType = mi.innerMapType
+		arrMR = append(arrMR, mr)
+	}
 
-		switch mi.innerMapType {
-		case RingBuffer:
-			rb, err := currMap.ringbufReader()
-			if err != nil {
-				return nil, mapErr.Throwf("%v", err)
-			}
+	return arrMR, nil
+}
 
-			arrMR = append(arrMR, rb)
-		case PerfEventArray:
-			pf, err := currMap.perfReader()
-			if err != nil {
-				return nil, mapErr.Throwf("%v", err)
-			}
+// innerMapReader creates a reader for the map stored at the given index of an array of eBPF maps.
+func (mi *MapInfo) innerMapReader(i uint32) (any, error) {
+	var innerMap *ebpf.Map
+	var currMap MapInfo
 
-			arrMR = append(arrMR, pf)
-		default:
-			return nil, mapErr.Throwf(ErrUnsupportedInnerBpfMapType, mi.innerMapType)
-		}
+	if err := mi.bpfMap.Lookup(&i, &innerMap); err != nil {
+		return nil, mapErr.Throwf("%v", err)
 	}
 
-	return arrMR, nil
+	currMap.bpfMap = innerMap
+	currMap.mapType = mi.innerMapType
+
+	switch mi.innerMapType {
+	case RingBuffer:
+		rb, err := currMap.ringbufReader()
+		if err != nil {
+			innerMap.Close()
+			return nil, mapErr.Throwf("%v", err)
+		}
+
+		return &innerRingbufReader{Reader: rb, innerMap: innerMap}, nil
+	case PerfEventArray:
+		// the perf reader holds its own copy of the map
+		defer innerMap.Close()
+
+		pf, err := currMap.perfReader()
+		if err != nil {
+			return nil, mapErr.Throwf("%v", err)
+		}
+
+		return pf, nil
+	default:
+		innerMap.Close()
+		return nil, mapErr.Throwf(ErrUnsupportedInnerBpfMapType, mi.innerMapType)
+	}
 }
 
 // ringbufReader creates a reader for a ring buffer eBPF map.
@@ -209,6 +243,13 @@ func read(readers []any) ([]func() ([]byte, error), error) {
 				return nil, err
 			}
 
+			funcs = append(funcs, f)
+		case *innerRingbufReader:
+			f, err := readRingbuf(r.Reader)
+			if err != nil {
+				return nil, err
+			}
+
 			funcs = append(funcs, f)
 		case *perf.Reader:
 			f, err := readPerf(r)
@@ -271,6 +312,11 @@ func closeMapReaders(readers []any) error {
 			if err != nil {
 				return mapErr.Throwf("%v", err)
 			}
+		case *innerRingbufReader:
+			err := mr.Close()
+			if err != nil {
+				return mapErr.Throwf("%v", err)
+			}
 		default:
 			return mapErr.Throwf(ErrUnsupportedMapReader, mr)
 		}
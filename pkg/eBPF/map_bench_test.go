@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+)
+
+// benchSampleSize is the size of the samples written by the benchmark programs, in bytes.
+const benchSampleSize = 64
+
+// outputProgram returns a socket filter writing a zeroed sample of benchSampleSize bytes
+// to the given map with bpf_ringbuf_output or bpf_perf_event_output, depending on the map type.
+func outputProgram(b *testing.B, m *ebpf.Map) *ebpf.Program {
+	insns := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+	}
+
+	for off := 8; off <= benchSampleSize; off += 8 {
+		insns = append(insns, asm.StoreImm(asm.RFP, int16(-off), 0, asm.DWord))
+	}
+
+	if m.Type() == ebpf.RingBuf {
+		insns = append(insns,
+			asm.LoadMapPtr(asm.R1, m.FD()),
+			asm.Mov.Reg(asm.R2, asm.RFP),
+			asm.Add.Imm(asm.R2, -benchSampleSize),
+			asm.Mov.Imm(asm.R3, benchSampleSize),
+			asm.Mov.Imm(asm.R4, 0),
+			asm.FnRingbufOutput.Call(),
+		)
+	} else {
+		insns = append(insns,
+			asm.Mov.Reg(asm.R1, asm.R6),
+			asm.LoadMapPtr(asm.R2, m.FD()),
+			asm.LoadImm(asm.R3, 0xffffffff, asm.DWord), // BPF_F_CURRENT_CPU
+			asm.Mov.Reg(asm.R4, asm.RFP),
+			asm.Add.Imm(asm.R4, -benchSampleSize),
+			asm.Mov.Imm(asm.R5, benchSampleSize),
+			asm.FnPerfEventOutput.Call(),
+		)
+	}
+
+	insns = append(insns,
+		asm.Mov.Imm(asm.R0, 0),
+		asm.Return(),
+	)
+
+	prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+		Type:         ebpf.SocketFilter,
+		License:      "GPL",
+		Instructions: insns,
+	})
+	if err != nil {
+		b.Skipf("loading the output program: %v", err)
+	}
+
+	b.Cleanup(func() { prog.Close() })
+	return prog
+}
+
+// benchmarkTransport measures writing a sample from the kernel and reading it back through the readers of mi.
+func benchmarkTransport(b *testing.B, mi *MapInfo) {
+	prog := outputProgram(b, mi.GetBpfMap())
+
+	readers, err := mi.CreateReaders()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { closeMapReaders(readers) })
+
+	funcs, err := read(readers)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	opts := &ebpf.RunOptions{Data: make([]byte, 14)}
+
+	b.ReportAllocs()
+	b.SetBytes(benchSampleSize)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := prog.Run(opts); err != nil {
+			b.Fatal(err)
+		}
+
+		if _, err := funcs[0](); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkRingBuf benchmarks the ring buffer transport
+func BenchmarkRingBuf(b *testing.B) {
+	m, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type:       ebpf.RingBuf,
+		MaxEntries: uint32(os.Getpagesize()) * 16,
+	})
+	if err != nil {
+		b.Skipf("creating ring buffer: %v", err)
+	}
+	b.Cleanup(func() { m.Close() })
+
+	benchmarkTransport(b, NewRingBuf(m))
+}
+
+// BenchmarkPerfEventArray benchmarks the perf event array transport
+func BenchmarkPerfEventArray(b *testing.B) {
+	m, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type: ebpf.PerfEventArray,
+	})
+	if err != nil {
+		b.Skipf("creating perf event array: %v", err)
+	}
+	b.Cleanup(func() { m.Close() })
+
+	benchmarkTransport(b, NewPerfEvent(m))
+}
@@ -4,11 +4,18 @@
 package ebpf
 
 import (
+	"fmt"
+
 	"github.com/intelops/tarian-detector/pkg/err"
 )
 
 var moduleErr = err.New("ebpf.module")
 
+// loadKernelSymbols returns the symbol table used to resolve the kprobes of a module.
+var loadKernelSymbols = func() (*KernelSymbols, error) {
+	return LoadKernelSymbols(KallsymsPath)
+}
+
 // EbpfModule represents an eBPF module. It includes the name of the module, a slice of eBPF program information, and information about the eBPF map.
 type EbpfModule interface {
 	// GetModule is a method that returns a pointer to a Module and an error.
@@ -20,6 +27,7 @@ type Module struct {
 	name     string         // Name of the module.
 	programs []*ProgramInfo // Slice of eBPF program information.
 	ebpfMap  *MapInfo       // Information about the eBPF map.
+	pinPath  string         // Directory the links are pinned under, none if empty.
 }
 
 // NewModule creates a new eBPF module with the given name.
@@ -36,16 +44,46 @@ func (m *Module) AddProgram(prog *ProgramInfo) {
 	m.programs = append(m.programs, prog)
 }
 
+// PinLinks pins the links of the programs under path when the module is prepared, so that they stay
+// attached across restarts, and re-adopts the links pinned there by a previous run. Only the bpf links,
+// of raw tracepoints, fentry, fexit and LSM programs, can be pinned; the other programs are attached
+// again on every run. The path should be the directory the maps of the programs are pinned in, since a
+// re-adopted link keeps the program loaded by the previous run.
+func (m *Module) PinLinks(path string) {
+	m.pinPath = path
+}
+
 // Map sets the eBPF map for the module..
 func (m *Module) Map(mp *MapInfo) {
 	m.ebpfMap = mp
 }
 
-// Prepare initializes the module and returns a handler along with any errors encountered.
-func (m *Module) Prepare() (*Handler, error) {
+// PrepareOptions holds the options of Module.Prepare.
+type PrepareOptions struct {
+	// Strict aborts Prepare on the first program failing to attach and detaches the programs
+	// attached before it. By default the failure is recorded in the report and Prepare continues.
+	Strict bool
+}
+
+// Prepare attaches the programs of the module and creates the map readers. It returns a handler
+// whose report lists every program selected for attaching as attached, skipped or failed.
+// Programs whose kernel symbol is missing are always skipped. On error, the probes attached
+// so far are detached before returning.
+func (m *Module) Prepare(op ...PrepareOptions) (*Handler, error) {
+	var opts PrepareOptions
+	if len(op) > 0 {
+		opts = op[0]
+	}
+
 	// Create a new handler with the provided module name.
 	handler := NewHandler(m.name)
 
+	// Without a readable symbol table the kprobes are attached to the symbols they name
+	ks, err := loadKernelSymbols()
+	if err != nil {
+		handler.report.warn(fmt.Sprintf("kprobes attached without resolving their symbols: %v", err))
+	}
+
 	// Attach programs to the kernel hook points
 	for _, prog := range m.programs {
 		hook := prog.hook
@@ -54,19 +92,47 @@ func (m *Module) Prepare() (*Handler, error) {
 			continue
 		}
 
+		// A program whose kernel symbol is missing is skipped rather than failing the module
+		if err := hook.Resolve(ks); err != nil {
+			handler.AddSkippedProgram(prog, err.Error())
+			continue
+		}
+
+		// A link pinned by a previous run keeps its program attached and is adopted as is
+		pin := len(m.pinPath) != 0 && pinnable(hook)
+		if pin {
+			if pL, err := loadPinnedLink(m.pinPath, hook); err == nil {
+				handler.addAttachedProgram(prog, pL, "re-adopted pinned link")
+				continue
+			}
+		}
+
 		pL, err := hook.AttachProbe(prog.name)
 		if err != nil {
-			return nil, moduleErr.Throwf("%v", err)
+			handler.AddFailedProgram(prog, err.Error())
+
+			if opts.Strict {
+				return nil, rollback(handler, moduleErr.Throwf("%s: %v", hook, err))
+			}
+
+			continue
+		}
+
+		if pin {
+			if err := pinLink(m.pinPath, hook, pL); err != nil {
+				handler.addAttachedProgram(prog, pL, "not pinned: "+err.Error())
+				continue
+			}
 		}
 
-		handler.AddProbeLink(pL)
+		handler.AddAttachedProgram(prog, pL)
 	}
 
 	// Create map reader to receive data from the kernel
 	if m.ebpfMap != nil {
 		mrs, err := m.ebpfMap.CreateReaders()
 		if err != nil {
-			return nil, moduleErr.Throwf("%v", err)
+			return nil, rollback(handler, moduleErr.Throwf("%v", err))
 		}
 
 		handler.AddMapReaders(mrs)
@@ -75,6 +141,23 @@ func (m *Module) Prepare() (*Handler, error) {
 	return handler, nil
 }
 
+// rollback detaches the probes attached by the handler, including the pinned ones, and returns the
+// error that caused the rollback.
+func rollback(h *Handler, cause error) error {
+	msg := cause.Error()
+	for _, l := range h.probeLinks {
+		if err := unpinProbe(l); err != nil {
+			return moduleErr.Throwf("%s; rollback failed: %v", msg, err)
+		}
+	}
+
+	if err := detachProbes(h.probeLinks); err != nil {
+		return moduleErr.Throwf("%s; rollback failed: %v", msg, err)
+	}
+
+	return cause
+}
+
 // GetName returns the name of the Module.
 func (m *Module) GetName() string {
 	return m.name
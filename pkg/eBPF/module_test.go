@@ -6,6 +6,7 @@ package ebpf
 import (
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cilium/ebpf"
@@ -309,10 +310,13 @@ func TestModule_Prepare(t *testing.T) {
 		ebpfMap  *MapInfo
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		want    *Handler
-		wantErr bool
+		name        string
+		fields      fields
+		opts        PrepareOptions
+		want        *Handler
+		wantSkipped int
+		wantFailed  int
+		wantErr     bool
 	}{
 		{
 			name: "valid values with nil program and map",
@@ -368,6 +372,22 @@ func TestModule_Prepare(t *testing.T) {
 
 			wantErr: false,
 		},
+		{
+			name: "missing kernel symbol",
+			fields: fields{
+				name: "test",
+				programs: []*ProgramInfo{
+					NewProgram(prog, NewHookInfo().Kprobe("__tarian_missing_symbol")),
+				},
+				ebpfMap: nil,
+			},
+			want: &Handler{
+				name: "test",
+			},
+			wantSkipped: 1,
+
+			wantErr: false,
+		},
 		{
 			name: "nil ebpf prog",
 			fields: fields{
@@ -377,6 +397,24 @@ func TestModule_Prepare(t *testing.T) {
 				},
 				ebpfMap: nil,
 			},
+			want: &Handler{
+				name: "test",
+			},
+			wantFailed: 1,
+
+			wantErr: false,
+		},
+		{
+			name: "nil ebpf prog with strict attach",
+			fields: fields{
+				name: "test",
+				programs: []*ProgramInfo{
+					NewProgram(prog, NewHookInfo().Kprobe("vprintk")),
+					NewProgram(prog, NewHookInfo().Kprobe("")),
+				},
+				ebpfMap: nil,
+			},
+			opts: PrepareOptions{Strict: true},
 
 			wantErr: true,
 		},
@@ -400,7 +438,7 @@ func TestModule_Prepare(t *testing.T) {
 				programs: tt.fields.programs,
 				ebpfMap:  tt.fields.ebpfMap,
 			}
-			got, err := m.Prepare()
+			got, err := m.Prepare(tt.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Module.Prepare() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -421,6 +459,34 @@ func TestModule_Prepare(t *testing.T) {
 			if len(got.probeLinks) != len(tt.want.probeLinks) {
 				t.Errorf("Module.Prepare().probeLinks = %v, want %v", got.probeLinks, tt.want.probeLinks)
 			}
+
+			if got.Report().Count(Skipped) != tt.wantSkipped {
+				t.Errorf("Module.Prepare().Report() = %v, want %v skipped", got.Report(), tt.wantSkipped)
+			}
+
+			if got.Report().Count(Failed) != tt.wantFailed {
+				t.Errorf("Module.Prepare().Report() = %v, want %v failed", got.Report(), tt.wantFailed)
+			}
 		})
 	}
 }
+
+// TestModule_Prepare_kernelSymbols tests that an unreadable symbol table is reported
+func TestModule_Prepare_kernelSymbols(t *testing.T) {
+	load := loadKernelSymbols
+	t.Cleanup(func() { loadKernelSymbols = load })
+
+	loadKernelSymbols = func() (*KernelSymbols, error) {
+		return nil, os.ErrPermission
+	}
+
+	h, err := NewModule("test").Prepare()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	warnings := h.Report().Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], os.ErrPermission.Error()) {
+		t.Errorf("Module.Prepare().Report().Warnings() = %v, want the symbol table error", warnings)
+	}
+}
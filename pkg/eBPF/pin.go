@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var pinErr = err.New("ebpf.pin")
+
+// DefaultPinPath is the directory of the BPF file system the maps and links of the detector are pinned under.
+const DefaultPinPath = "/sys/fs/bpf/tarian"
+
+// linksDir is the directory of the pin path holding the pinned links.
+const linksDir = "links"
+
+// PinMaps marks the maps of the spec to be pinned by name under a directory of path named after the
+// version of the spec, and returns the map options to load the spec with. Maps already pinned there
+// by a run of the same spec are re-adopted instead of created, so that their content, e.g. unread
+// events, survives a restart. The objects pinned under path by other versions are removed, since the
+// kernel refuses to re-adopt a map whose layout changed. Data sections are loaded again with the programs.
+func PinMaps(spec *ebpf.CollectionSpec, path string) (ebpf.MapOptions, error) {
+	version := specVersion(spec)
+	if err := pruneVersions(path, version); err != nil {
+		return ebpf.MapOptions{}, err
+	}
+
+	dir := filepath.Join(path, version)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return ebpf.MapOptions{}, pinErr.Throwf("%v", err)
+	}
+
+	for name, m := range spec.Maps {
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		m.Pinning = ebpf.PinByName
+	}
+
+	return ebpf.MapOptions{PinPath: dir}, nil
+}
+
+// specVersion returns a digest of the maps, including the content of the data sections, and of the
+// programs of the spec. Specs differing in anything the kernel sees have different versions.
+func specVersion(spec *ebpf.CollectionSpec) string {
+	h := sha256.New()
+
+	maps := make([]string, 0, len(spec.Maps))
+	for name := range spec.Maps {
+		maps = append(maps, name)
+	}
+
+	sort.Strings(maps)
+	for _, name := range maps {
+		m := spec.Maps[name]
+		fmt.Fprintf(h, "map %s %v %d %d %d %d %v\n", name, m.Type, m.KeySize, m.ValueSize, m.MaxEntries, m.Flags, m.Contents)
+
+		if m.InnerMap != nil {
+			im := m.InnerMap
+			fmt.Fprintf(h, "inner %v %d %d %d %d\n", im.Type, im.KeySize, im.ValueSize, im.MaxEntries, im.Flags)
+		}
+	}
+
+	progs := make([]string, 0, len(spec.Programs))
+	for name := range spec.Programs {
+		progs = append(progs, name)
+	}
+
+	sort.Strings(progs)
+	for _, name := range progs {
+		p := spec.Programs[name]
+		fmt.Fprintf(h, "prog %s %v %v %s\n%v\n", name, p.Type, p.AttachType, p.AttachTo, p.Instructions)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// pruneVersions removes everything pinned under path but the directory of the given version. The
+// links pinned by other versions are detached once no running detector holds them.
+func pruneVersions(path, version string) error {
+	entries, err := os.ReadDir(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return pinErr.Throwf("%v", err)
+	}
+
+	for _, e := range entries {
+		if e.Name() == version {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(path, e.Name())); err != nil {
+			return pinErr.Throwf("%v", err)
+		}
+	}
+
+	return nil
+}
+
+// LinkPinPath returns the path the link of the given hook is pinned at under path, e.g.
+// <path>/links/LSM_file_open.
+func LinkPinPath(path string, hi *HookInfo) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '.' || r == ':' {
+			return '_'
+		}
+
+		return r
+	}, hi.String())
+
+	return filepath.Join(path, linksDir, name)
+}
+
+// loadPinnedLink re-adopts the link of the hook pinned under path by a previous run.
+func loadPinnedLink(path string, hi *HookInfo) (link.Link, error) {
+	return link.LoadPinnedLink(LinkPinPath(path, hi), nil)
+}
+
+// pinnable reports whether the links of the hook are bpf links, which can be pinned. The links of
+// kprobes, uprobes and tracepoints are backed by perf events and detached when the detector exits.
+func pinnable(hi *HookInfo) bool {
+	switch hi.hookType {
+	case RawTracepoint, Fentry, Fexit, LSM:
+		return true
+	default:
+		return false
+	}
+}
+
+// pinLink pins the link of the hook under path, so that it stays attached after the detector exits.
+// Raw tracepoints attached on kernels without bpf links can not be pinned either.
+func pinLink(path string, hi *HookInfo, l link.Link) error {
+	if err := os.MkdirAll(filepath.Join(path, linksDir), 0700); err != nil {
+		return pinErr.Throwf("%v", err)
+	}
+
+	if err := l.Pin(LinkPinPath(path, hi)); err != nil {
+		return pinErr.Throwf("%v", err)
+	}
+
+	return nil
+}
+
+// unpinProbe removes the pin of the provided link, if any, so that closing it detaches the probe.
+func unpinProbe(l link.Link) error {
+	err := l.Unpin()
+	if errors.Is(err, link.ErrNotSupported) {
+		return nil
+	}
+
+	return err
+}
+
+// Unpin removes the maps and links pinned under path. The links are detached once no running
+// detector holds them.
+func Unpin(path string) error {
+	if len(path) == 0 {
+		return pinErr.Throw("empty pin path")
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return pinErr.Throwf("%v", err)
+	}
+
+	return nil
+}
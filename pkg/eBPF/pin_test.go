@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/link"
+)
+
+// TestPinMaps tests that every map but the data sections is pinned by name under the version of the spec
+func TestPinMaps(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tarian")
+	spec := &ebpf.CollectionSpec{
+		Maps: map[string]*ebpf.MapSpec{
+			"events":  {Name: "events", Type: ebpf.PerfEventArray},
+			".rodata": {Name: ".rodata", Type: ebpf.Array},
+		},
+	}
+
+	// Objects of a previous version and of the layout without versions
+	stale := []string{filepath.Join(path, "0123456789abcdef"), filepath.Join(path, "events")}
+	for _, p := range stale {
+		if err := os.MkdirAll(p, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := PinMaps(spec, path)
+	if err != nil {
+		t.Fatalf("PinMaps() error = %v", err)
+	}
+
+	if want := filepath.Join(path, specVersion(spec)); got.PinPath != want {
+		t.Errorf("PinMaps().PinPath = %v, want %v", got.PinPath, want)
+	}
+
+	if _, err := os.Stat(got.PinPath); err != nil {
+		t.Errorf("PinMaps() did not create %v: %v", got.PinPath, err)
+	}
+
+	for _, p := range stale {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("PinMaps() left %v", p)
+		}
+	}
+
+	tests := []struct {
+		name string
+		want ebpf.PinType
+	}{
+		{name: "events", want: ebpf.PinByName},
+		{name: ".rodata", want: ebpf.PinNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := spec.Maps[tt.name].Pinning; got != tt.want {
+				t.Errorf("PinMaps() %s pinning = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	// A second run of the same spec keeps its pinned objects
+	if _, err := PinMaps(spec, path); err != nil {
+		t.Fatalf("PinMaps() error = %v", err)
+	}
+
+	if _, err := os.Stat(got.PinPath); err != nil {
+		t.Errorf("PinMaps() removed the pins of its own version: %v", err)
+	}
+}
+
+// Test_specVersion tests that the version of a spec changes with the layout of its maps and its programs
+func Test_specVersion(t *testing.T) {
+	spec := func() *ebpf.CollectionSpec {
+		return &ebpf.CollectionSpec{
+			Maps: map[string]*ebpf.MapSpec{
+				"events":  {Name: "events", Type: ebpf.RingBuf, MaxEntries: 1 << 20},
+				".rodata": {Name: ".rodata", Type: ebpf.Array, KeySize: 4, ValueSize: 1, MaxEntries: 1, Contents: []ebpf.MapKV{{Key: uint32(0), Value: []byte{0}}}},
+			},
+			Programs: map[string]*ebpf.ProgramSpec{
+				"tdf_execve_e": {Name: "tdf_execve_e", Type: ebpf.Kprobe, Instructions: asm.Instructions{asm.Mov.Imm(asm.R0, 0), asm.Return()}},
+			},
+		}
+	}
+
+	base := specVersion(spec())
+
+	tests := []struct {
+		name   string
+		modify func(*ebpf.CollectionSpec)
+		want   bool
+	}{
+		{name: "same spec", modify: func(*ebpf.CollectionSpec) {}, want: true},
+		{name: "resized map", modify: func(s *ebpf.CollectionSpec) { s.Maps["events"].MaxEntries = 1 << 21 }, want: false},
+		{name: "retyped map", modify: func(s *ebpf.CollectionSpec) { s.Maps["events"].Type = ebpf.PerfEventArray }, want: false},
+		{name: "rewritten constant", modify: func(s *ebpf.CollectionSpec) { s.Maps[".rodata"].Contents[0].Value = []byte{1} }, want: false},
+		{name: "changed program", modify: func(s *ebpf.CollectionSpec) { s.Programs["tdf_execve_e"].Instructions[0] = asm.Mov.Imm(asm.R0, 1) }, want: false},
+		{name: "pinning", modify: func(s *ebpf.CollectionSpec) { s.Maps["events"].Pinning = ebpf.PinByName }, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := spec()
+			tt.modify(s)
+
+			if got := specVersion(s) == base; got != tt.want {
+				t.Errorf("specVersion() unchanged = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_pinnable tests the pinnable function
+func Test_pinnable(t *testing.T) {
+	tests := []struct {
+		name string
+		hook *HookInfo
+		want bool
+	}{
+		{name: "kprobe", hook: NewHookInfo().Kprobe("__x64_sys_execve"), want: false},
+		{name: "kretprobe", hook: NewHookInfo().Kretprobe("__x64_sys_execve"), want: false},
+		{name: "tracepoint", hook: NewHookInfo().Tracepoint("syscalls", "sys_enter_execve"), want: false},
+		{name: "uprobe", hook: NewHookInfo().Uprobe("/usr/lib/libssl.so.3", "SSL_write"), want: false},
+		{name: "raw tracepoint", hook: NewHookInfo().RawTracepoint(link.RawTracepointOptions{Name: "sys_enter"}), want: true},
+		{name: "lsm", hook: NewHookInfo().LSM("file_open"), want: true},
+		{name: "fentry", hook: NewHookInfo().Fentry("tcp_connect"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pinnable(tt.hook); got != tt.want {
+				t.Errorf("pinnable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLinkPinPath tests the LinkPinPath function
+func TestLinkPinPath(t *testing.T) {
+	tests := []struct {
+		name string
+		hook *HookInfo
+		want string
+	}{
+		{
+			name: "lsm",
+			hook: NewHookInfo().LSM("file_open"),
+			want: "/sys/fs/bpf/tarian/links/LSM_file_open",
+		},
+		{
+			name: "kprobe",
+			hook: NewHookInfo().Kprobe("__x64_sys_execve"),
+			want: "/sys/fs/bpf/tarian/links/Kprobe___x64_sys_execve",
+		},
+		{
+			name: "kretprobe with resolved symbol",
+			hook: &HookInfo{hookType: Kretprobe, name: "__x64_sys_open", symbol: "do_sys_open.isra.0"},
+			want: "/sys/fs/bpf/tarian/links/Kretprobe_do_sys_open_isra_0",
+		},
+		{
+			name: "tracepoint",
+			hook: NewHookInfo().Tracepoint("syscalls", "sys_enter_execve"),
+			want: "/sys/fs/bpf/tarian/links/Tracepoint_syscalls_sys_enter_execve",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LinkPinPath(DefaultPinPath, tt.hook); got != tt.want {
+				t.Errorf("LinkPinPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestUnpin tests the Unpin function
+func TestUnpin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tarian")
+	if err := os.MkdirAll(filepath.Join(path, linksDir), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "pinned objects", path: path},
+		{name: "nothing pinned", path: path},
+		{name: "empty path", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Unpin(tt.path); (err != nil) != tt.wantErr {
+				t.Errorf("Unpin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Errorf("Unpin() left %v", path)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import "fmt"
+
+// AttachStatus is an integer type used to represent the outcome of attaching an eBPF program.
+type AttachStatus int
+
+// Constants representing the outcomes of attaching an eBPF program.
+const (
+	Attached AttachStatus = iota // The program is attached to its hook
+	Skipped                      // The program was not attached because its hook is unavailable
+	Failed                       // Attaching the program returned an error
+)
+
+// ProgramReport records the outcome of attaching a single eBPF program.
+type ProgramReport struct {
+	Program *ProgramInfo // Program the report refers to
+	Hook    string       // Hook the program was attached to, as returned by HookInfo.String
+	Status  AttachStatus // Outcome of attaching the program
+	Reason  string       // Reason the program was skipped or failed, or a note on how it was attached
+}
+
+// AttachReport records the outcome of attaching the programs of a module, in attach order.
+type AttachReport struct {
+	programs []ProgramReport // Reports of the programs selected for attaching
+	warnings []string        // Problems affecting the attachment of every program, e.g. an unreadable symbol table
+}
+
+// add appends the outcome of attaching the given program to the report.
+func (r *AttachReport) add(p *ProgramInfo, status AttachStatus, reason string) {
+	hook := ""
+	if p != nil && p.hook != nil {
+		hook = p.hook.String()
+	}
+
+	r.programs = append(r.programs, ProgramReport{
+		Program: p,
+		Hook:    hook,
+		Status:  status,
+		Reason:  reason,
+	})
+}
+
+// warn records a problem affecting the attachment of every program.
+func (r *AttachReport) warn(msg string) {
+	r.warnings = append(r.warnings, msg)
+}
+
+// Warnings returns the problems affecting the attachment of every program.
+func (r *AttachReport) Warnings() []string {
+	return r.warnings
+}
+
+// Programs returns the reports of all the programs selected for attaching.
+func (r *AttachReport) Programs() []ProgramReport {
+	return r.programs
+}
+
+// Lookup returns the report of the given program and whether the program is part of the report.
+func (r *AttachReport) Lookup(p *ProgramInfo) (ProgramReport, bool) {
+	for _, pr := range r.programs {
+		if pr.Program == p {
+			return pr, true
+		}
+	}
+
+	return ProgramReport{}, false
+}
+
+// Count returns the number of programs with the given status.
+func (r *AttachReport) Count(status AttachStatus) int {
+	n := 0
+	for _, pr := range r.programs {
+		if pr.Status == status {
+			n++
+		}
+	}
+
+	return n
+}
+
+// String returns a summary of the report, e.g. "30 attached, 2 skipped, 0 failed".
+func (r *AttachReport) String() string {
+	return fmt.Sprintf("%d %s, %d %s, %d %s", r.Count(Attached), Attached, r.Count(Skipped), Skipped, r.Count(Failed), Failed)
+}
+
+// String method for the AttachStatus type. It returns a string representation of the AttachStatus.
+func (s AttachStatus) String() string {
+	switch s {
+	case Attached:
+		return "attached"
+	case Skipped:
+		return "skipped"
+	case Failed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown AttachStatus(%d)", int(s))
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import "testing"
+
+// TestAttachReport tests the Count and Programs functions
+func TestAttachReport(t *testing.T) {
+	var r AttachReport
+	r.add(NewProgram(nil, NewHookInfo().Kprobe("__x64_sys_execve")), Attached, "")
+	r.add(NewProgram(nil, NewHookInfo().Kretprobe("__x64_sys_execve")), Attached, "")
+	r.add(NewProgram(nil, NewHookInfo().Kprobe("__x64_sys_openat2")), Skipped, "symbol missing")
+
+	if got := len(r.Programs()); got != 3 {
+		t.Errorf("AttachReport.Programs() = %v, want %v", got, 3)
+	}
+
+	if got := r.Programs()[2].Hook; got != "Kprobe/__x64_sys_openat2" {
+		t.Errorf("AttachReport.Programs()[2].Hook = %v, want %v", got, "Kprobe/__x64_sys_openat2")
+	}
+
+	tests := []struct {
+		name   string
+		status AttachStatus
+		want   int
+	}{
+		{name: "attached", status: Attached, want: 2},
+		{name: "skipped", status: Skipped, want: 1},
+		{name: "failed", status: Failed, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Count(tt.status); got != tt.want {
+				t.Errorf("AttachReport.Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAttachStatus_String tests the String function
+func TestAttachStatus_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		status AttachStatus
+		want   string
+	}{
+		{name: "attached", status: Attached, want: "attached"},
+		{name: "skipped", status: Skipped, want: "skipped"},
+		{name: "failed", status: Failed, want: "failed"},
+		{name: "unknown", status: -1, want: "unknown AttachStatus(-1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.String(); got != tt.want {
+				t.Errorf("AttachStatus.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
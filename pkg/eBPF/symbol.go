@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import "runtime"
+
+// syscallPrefixes maps an architecture, named after GOARCH, to the prefix of its syscall wrappers in the kernel.
+var syscallPrefixes = map[string]string{
+	"amd64": "__x64_sys_",
+	"arm64": "__arm64_sys_",
+}
+
+// unwrappedSyscallPrefixes lists the prefixes of the syscall functions taking the syscall arguments
+// directly, the only ones exported by kernels built without syscall wrappers. The syscall programs
+// read the arguments from the struct pt_regs passed to the wrappers and can not be attached to them.
+var unwrappedSyscallPrefixes = []string{
+	"__se_sys_",
+	"sys_",
+}
+
+// SyscallSymbol returns the kernel symbol of the named syscall on the architecture the detector runs on.
+func SyscallSymbol(name string) string {
+	return ArchSyscallSymbol(runtime.GOARCH, name)
+}
+
+// ArchSyscallSymbol returns the kernel symbol of the named syscall on the given architecture.
+// Architectures without syscall wrappers use the plain sys_ symbol.
+func ArchSyscallSymbol(arch, name string) string {
+	prefix, ok := syscallPrefixes[arch]
+	if !ok {
+		prefix = "sys_"
+	}
+
+	return prefix + name
+}
+
+// UnwrappedSyscallSymbol returns the first function of the named syscall taking the syscall arguments
+// directly that is exported by the kernel. It explains why a syscall without wrapper can not be probed.
+func UnwrappedSyscallSymbol(ks *KernelSymbols, name string) (string, bool) {
+	if ks == nil {
+		return "", false
+	}
+
+	candidates := make([]string, 0, len(unwrappedSyscallPrefixes))
+	for _, prefix := range unwrappedSyscallPrefixes {
+		candidates = append(candidates, prefix+name)
+	}
+
+	return ks.Resolve(candidates...)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package ebpf
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestArchSyscallSymbol tests the ArchSyscallSymbol function
+func TestArchSyscallSymbol(t *testing.T) {
+	tests := []struct {
+		name    string
+		arch    string
+		syscall string
+		want    string
+	}{
+		{name: "amd64", arch: "amd64", syscall: "execve", want: "__x64_sys_execve"},
+		{name: "arm64", arch: "arm64", syscall: "execve", want: "__arm64_sys_execve"},
+		{name: "unknown arch", arch: "mips", syscall: "execve", want: "sys_execve"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ArchSyscallSymbol(tt.arch, tt.syscall); got != tt.want {
+				t.Errorf("ArchSyscallSymbol() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestUnwrappedSyscallSymbol tests the UnwrappedSyscallSymbol function
+func TestUnwrappedSyscallSymbol(t *testing.T) {
+	ks, err := ParseKernelSymbols(strings.NewReader(testKallsyms))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		ks      *KernelSymbols
+		syscall string
+		want    string
+		wantOk  bool
+	}{
+		{name: "se_sys function", ks: ks, syscall: "openat", want: "__se_sys_openat", wantOk: true},
+		{name: "sys function", ks: ks, syscall: "close", want: "sys_close", wantOk: true},
+		{name: "wrapper only", ks: ks, syscall: "execve", wantOk: false},
+		{name: "nil symbol table", syscall: "openat", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := UnwrappedSyscallSymbol(tt.ks, tt.syscall)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("UnwrappedSyscallSymbol() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import "sync"
+
+// ringCache remembers a bounded number of values, the oldest keys are forgotten first.
+type ringCache[K comparable] struct {
+	mu sync.Mutex
+
+	values map[K]string
+	order  []K // Keys in insertion order, a ring of size capacity
+	next   int // Position of the next key in order
+	size   int // Capacity of the cache
+}
+
+// newRingCache creates a cache remembering up to size values.
+func newRingCache[K comparable](size int) *ringCache[K] {
+	return &ringCache[K]{
+		values: make(map[K]string, size),
+		order:  make([]K, 0, size),
+		size:   size,
+	}
+}
+
+// remember records the value of the key, replacing the value of a key seen before.
+func (rc *ringCache[K]) remember(key K, value string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.values[key]; ok {
+		rc.values[key] = value
+		return
+	}
+
+	if len(rc.order) < rc.size {
+		rc.order = append(rc.order, key)
+	} else {
+		delete(rc.values, rc.order[rc.next])
+		rc.order[rc.next] = key
+	}
+
+	rc.next = (rc.next + 1) % rc.size
+	rc.values[key] = value
+}
+
+// lookup returns the value of the key and whether it is known.
+func (rc *ringCache[K]) lookup(key K) (string, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	value, ok := rc.values[key]
+	return value, ok
+}
@@ -0,0 +1,443 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var dnsErr = err.New("eventparser.dns")
+
+const (
+	// dnsPort is the port of the DNS servers, the messages sent to or received from it are decoded.
+	dnsPort = 53
+
+	// maxResolutions is the number of resolved addresses remembered, the oldest are forgotten first.
+	maxResolutions = 16384
+
+	dnsHeaderSize = 12  // Size of the header of a DNS message
+	maxDnsPointer = 16  // Compression pointers followed in a name before it is considered a loop
+	maxDnsName    = 255 // Maximum length of a name
+)
+
+// dnsTypes maps the DNS record types to their names.
+var dnsTypes = map[uint16]string{
+	1:   "A",
+	2:   "NS",
+	5:   "CNAME",
+	6:   "SOA",
+	12:  "PTR",
+	15:  "MX",
+	16:  "TXT",
+	28:  "AAAA",
+	33:  "SRV",
+	64:  "SVCB",
+	65:  "HTTPS",
+	255: "ANY",
+}
+
+// dnsRcodes holds the names of the DNS response codes, indexed by code.
+var dnsRcodes = []string{
+	"NOERROR",
+	"FORMERR",
+	"SERVFAIL",
+	"NXDOMAIN",
+	"NOTIMP",
+	"REFUSED",
+	"YXDOMAIN",
+	"YXRRSET",
+	"NXRRSET",
+	"NOTAUTH",
+	"NOTZONE",
+}
+
+// dnsQuestion is the question of a DNS message.
+type dnsQuestion struct {
+	name  string
+	qtype uint16
+}
+
+// dnsAnswer is a resource record of the answer section of a DNS message.
+type dnsAnswer struct {
+	name  string
+	rtype uint16
+	data  string // Address of A and AAAA records, name of CNAME, NS and PTR records, size of the others
+}
+
+// dnsMessage holds the fields of a DNS query or response.
+type dnsMessage struct {
+	id        uint16
+	response  bool
+	rcode     uint8
+	questions []dnsQuestion
+	answers   []dnsAnswer // Answers decoded before the end of the captured payload
+}
+
+// dnsParams maps the events carrying DNS messages to their address and payload parameters.
+var dnsParams = map[TarianEventsE]struct{ addr, data string }{
+	TDE_SYSCALL_SENDTO_E:   {"addr", "data"},
+	TDE_SYSCALL_SENDMSG_E:  {"addr", "data"},
+	TDE_SYSCALL_RECVFROM_R: {"addr", "data"},
+	TDE_SYSCALL_RECVMSG_R:  {"addr", "data"},
+}
+
+// connectParams maps the events connecting a socket to the parameter holding the remote address.
+var connectParams = map[TarianEventsE]string{
+	TDE_SYSCALL_CONNECT_E:  "uservaddr",
+	TDE_CONNECTION_CONNECT: "remote",
+}
+
+// resolutionKey identifies an address resolved by a process.
+type resolutionKey struct {
+	process processKey
+	addr    netip.Addr
+}
+
+// peerKey identifies a socket connected by a process.
+type peerKey struct {
+	process processKey
+	fd      int32
+}
+
+// resolutionCache remembers the names the processes resolved to addresses, to name the
+// addresses they connect to, and the peers of the sockets they connected, to decode the
+// DNS messages they send without an address.
+type resolutionCache struct {
+	*ringCache[resolutionKey]
+	peers *ringCache[peerKey] // Address and port of the peer, as formatted by netip.AddrPort
+}
+
+// resolutions is the cache of the DNS responses seen by ParseByteArray.
+var resolutions = newResolutionCache(maxResolutions)
+
+// newResolutionCache creates a resolution cache remembering up to size addresses.
+func newResolutionCache(size int) *resolutionCache {
+	return &resolutionCache{newRingCache[resolutionKey](size), newRingCache[peerKey](size)}
+}
+
+// annotate decodes the DNS messages exchanged with port 53 and appends their fields to the arguments,
+// remembering the addresses of the responses. The connections of a process to an address it resolved
+// are annotated with the resolved name as hostname. The values are the raw values of the arguments.
+//
+// The messages sent without an address on a connected socket are sent to the peer given to connect.
+// The messages received without an address are not decoded, their events do not hold the socket.
+func (rc *resolutionCache) annotate(id TarianEventsE, metaData TarianMetaData, args []arg, values []any) []arg {
+	task := metaData.MetaData.Task
+	process := processKey{pidNs: task.PidNsId, pid: task.Pid}
+
+	if name, ok := connectParams[id]; ok {
+		addr, port, ok := sockaddrOf(args, values, name)
+		if !ok {
+			return args
+		}
+
+		if fd, ok := valueOf(args, values, "fd").(int32); ok && id == TDE_SYSCALL_CONNECT_E {
+			rc.peers.remember(peerKey{process: process, fd: fd}, netip.AddrPortFrom(addr, port).String())
+		}
+
+		host, ok := rc.lookup(resolutionKey{process: process, addr: addr})
+		if !ok {
+			return args
+		}
+
+		return append(args, arg{Name: "hostname", Value: host, TarianType: uint32(TDT_STR), LinuxType: "char *"})
+	}
+
+	params, ok := dnsParams[id]
+	if !ok {
+		return args
+	}
+
+	_, port, ok := sockaddrOf(args, values, params.addr)
+	if !ok {
+		port, ok = rc.peerPort(process, args, values)
+	}
+
+	if !ok || port != dnsPort {
+		return args
+	}
+
+	payload, _ := valueOf(args, values, params.data).([]byte)
+
+	msg, err := decodeDNS(payload)
+	if err != nil {
+		return args
+	}
+
+	if msg.response && msg.rcode == 0 && len(msg.questions) != 0 {
+		for _, ans := range msg.answers {
+			if ans.rtype != 1 && ans.rtype != 28 {
+				continue
+			}
+
+			if addr, err := netip.ParseAddr(ans.data); err == nil {
+				rc.remember(resolutionKey{process: process, addr: addr.Unmap()}, msg.questions[0].name)
+			}
+		}
+	}
+
+	return append(args, msg.args()...)
+}
+
+// args returns the fields of the message as arguments: the query, its type and, for responses,
+// the response code and the answers.
+func (m dnsMessage) args() []arg {
+	str := func(name, value string) arg {
+		return arg{Name: name, Value: value, TarianType: uint32(TDT_STR), LinuxType: "char *"}
+	}
+
+	var args []arg
+	if len(m.questions) != 0 {
+		args = append(args, str("dns_query", m.questions[0].name), str("dns_type", dnsTypeName(m.questions[0].qtype)))
+	}
+
+	if !m.response {
+		return args
+	}
+
+	answers := make([]string, 0, len(m.answers))
+	for _, ans := range m.answers {
+		answers = append(answers, fmt.Sprintf("%s %s %s", ans.name, dnsTypeName(ans.rtype), ans.data))
+	}
+
+	return append(args, str("dns_rcode", dnsRcodeName(m.rcode)), str("dns_answers", strings.Join(answers, ", ")))
+}
+
+// dnsTypeName returns the name of the record type, or its number if it is unknown.
+func dnsTypeName(t uint16) string {
+	if name, ok := dnsTypes[t]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(t))
+}
+
+// dnsRcodeName returns the name of the response code, or its number if it is unknown.
+func dnsRcodeName(rcode uint8) string {
+	if int(rcode) < len(dnsRcodes) {
+		return dnsRcodes[rcode]
+	}
+
+	return strconv.Itoa(int(rcode))
+}
+
+// decodeDNS decodes the header, the questions and the answers of a DNS message. The payload may be
+// truncated by the capture, in which case the answers decoded so far are returned.
+func decodeDNS(b []byte) (dnsMessage, error) {
+	var m dnsMessage
+	if len(b) < dnsHeaderSize {
+		return m, dnsErr.Throwf("message of %d bytes is shorter than the header", len(b))
+	}
+
+	flags := binary.BigEndian.Uint16(b[2:])
+	if flags&0x7800 != 0 {
+		return m, dnsErr.Throwf("unsupported opcode %d", flags>>11&0xf)
+	}
+
+	m.id = binary.BigEndian.Uint16(b)
+	m.response = flags&0x8000 != 0
+	m.rcode = uint8(flags & 0xf)
+
+	qdcount := binary.BigEndian.Uint16(b[4:])
+	ancount := binary.BigEndian.Uint16(b[6:])
+	if qdcount == 0 || qdcount > 1 && !m.response {
+		return m, dnsErr.Throwf("unexpected number of questions %d", qdcount)
+	}
+
+	off := dnsHeaderSize
+	for i := 0; i < int(qdcount); i++ {
+		name, next, err := decodeDNSName(b, off)
+		if err != nil {
+			return m, err
+		}
+
+		if next+4 > len(b) {
+			return m, dnsErr.Throw("truncated question")
+		}
+
+		m.questions = append(m.questions, dnsQuestion{name: name, qtype: binary.BigEndian.Uint16(b[next:])})
+		off = next + 4
+	}
+
+	for i := 0; i < int(ancount); i++ {
+		ans, next, err := decodeDNSAnswer(b, off)
+		if err != nil {
+			break
+		}
+
+		m.answers = append(m.answers, ans)
+		off = next
+	}
+
+	return m, nil
+}
+
+// decodeDNSAnswer decodes the resource record at off and returns the offset following it.
+func decodeDNSAnswer(b []byte, off int) (dnsAnswer, int, error) {
+	name, next, err := decodeDNSName(b, off)
+	if err != nil {
+		return dnsAnswer{}, 0, err
+	}
+
+	// type, class, ttl and length of the data
+	if next+10 > len(b) {
+		return dnsAnswer{}, 0, dnsErr.Throw("truncated resource record")
+	}
+
+	ans := dnsAnswer{name: name, rtype: binary.BigEndian.Uint16(b[next:])}
+	rdlen := int(binary.BigEndian.Uint16(b[next+8:]))
+	start, end := next+10, next+10+rdlen
+	if end > len(b) {
+		return dnsAnswer{}, 0, dnsErr.Throw("truncated resource record data")
+	}
+
+	switch ans.rtype {
+	case 1, 28: // A, AAAA
+		addr, ok := netip.AddrFromSlice(b[start:end])
+		if !ok {
+			return dnsAnswer{}, 0, dnsErr.Throwf("invalid address of %d bytes", rdlen)
+		}
+
+		ans.data = addr.String()
+	case 2, 5, 12: // NS, CNAME, PTR
+		target, _, err := decodeDNSName(b, start)
+		if err != nil {
+			return dnsAnswer{}, 0, err
+		}
+
+		ans.data = target
+	default:
+		ans.data = fmt.Sprintf("%d bytes", rdlen)
+	}
+
+	return ans, end, nil
+}
+
+// decodeDNSName decodes the possibly compressed name at off and returns the offset following it.
+func decodeDNSName(b []byte, off int) (string, int, error) {
+	var labels []string
+	length, next, pointers := 0, -1, 0
+
+	for {
+		if off >= len(b) {
+			return "", 0, dnsErr.Throw("truncated name")
+		}
+
+		l := int(b[off])
+		switch {
+		case l == 0:
+			if next < 0 {
+				next = off + 1
+			}
+
+			if len(labels) == 0 {
+				return ".", next, nil
+			}
+
+			return strings.Join(labels, "."), next, nil
+		case l&0xc0 == 0xc0:
+			if off+1 >= len(b) {
+				return "", 0, dnsErr.Throw("truncated name pointer")
+			}
+
+			pointers++
+			if pointers > maxDnsPointer {
+				return "", 0, dnsErr.Throw("too many name pointers")
+			}
+
+			if next < 0 {
+				next = off + 2
+			}
+
+			off = int(binary.BigEndian.Uint16(b[off:]) & 0x3fff)
+		case l&0xc0 != 0:
+			return "", 0, dnsErr.Throwf("unsupported label type %#x", l&0xc0)
+		default:
+			if off+1+l > len(b) {
+				return "", 0, dnsErr.Throw("truncated label")
+			}
+
+			length += l + 1
+			if length > maxDnsName {
+				return "", 0, dnsErr.Throw("name too long")
+			}
+
+			labels = append(labels, string(b[off+1:off+1+l]))
+			off += 1 + l
+		}
+	}
+}
+
+// peerPort returns the port of the peer the socket of the fd argument was connected to.
+func (rc *resolutionCache) peerPort(process processKey, args []arg, values []any) (uint16, bool) {
+	fd, ok := valueOf(args, values, "fd").(int32)
+	if !ok {
+		return 0, false
+	}
+
+	peer, ok := rc.peers.lookup(peerKey{process: process, fd: fd})
+	if !ok {
+		return 0, false
+	}
+
+	addrPort, err := netip.ParseAddrPort(peer)
+	if err != nil {
+		return 0, false
+	}
+
+	return addrPort.Port(), true
+}
+
+// valueOf returns the raw value of the argument with the given name, or nil if there is none.
+func valueOf(args []arg, values []any, name string) any {
+	for i, a := range args {
+		if a.Name == name && i < len(values) {
+			return values[i]
+		}
+	}
+
+	return nil
+}
+
+// sockaddrOf returns the address and port of the socket address argument with the given name,
+// decoded from its raw value. IPv4-mapped IPv6 addresses are returned as IPv4 addresses.
+func sockaddrOf(args []arg, values []any, name string) (netip.Addr, uint16, bool) {
+	raw, ok := valueOf(args, values, name).([]byte)
+	if !ok {
+		return netip.Addr{}, 0, false
+	}
+
+	return decodeSockaddr(raw)
+}
+
+// decodeSockaddr decodes the address and port of an AF_INET or AF_INET6 socket address, as written
+// by the eBPF programs: the family, the address and the port, both in network byte order.
+func decodeSockaddr(b []byte) (netip.Addr, uint16, bool) {
+	if len(b) == 0 {
+		return netip.Addr{}, 0, false
+	}
+
+	var size int
+	switch b[0] {
+	case AF_INET:
+		size = 4
+	case AF_INET6:
+		size = 16
+	default:
+		return netip.Addr{}, 0, false
+	}
+
+	if len(b) < 1+size+2 {
+		return netip.Addr{}, 0, false
+	}
+
+	addr, _ := netip.AddrFromSlice(b[1 : 1+size])
+	return addr.Unmap(), binary.BigEndian.Uint16(b[1+size:]), true
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+// dnsName encodes a name as uncompressed labels.
+func dnsName(labels ...string) []byte {
+	var b []byte
+	for _, l := range labels {
+		b = append(b, byte(len(l)))
+		b = append(b, l...)
+	}
+
+	return append(b, 0)
+}
+
+// dnsQuery is a query for the A records of evil.example.com.
+var dnsQuery = append([]byte{
+	0x12, 0x34, 0x01, 0x00, 0, 1, 0, 0, 0, 0, 0, 0,
+}, append(dnsName("evil", "example", "com"), 0, 1, 0, 1)...)
+
+// dnsResponse answers dnsQuery with a compressed CNAME to cdn.example.com and its address.
+var dnsResponse = func() []byte {
+	b := []byte{0x12, 0x34, 0x81, 0x80, 0, 1, 0, 2, 0, 0, 0, 0}
+	b = append(b, dnsName("evil", "example", "com")...)
+	b = append(b, 0, 1, 0, 1)
+
+	// evil.example.com CNAME cdn.example.com, pointing to the question and to example.com at offset 17
+	cname := append([]byte{3}, "cdn"...)
+	cname = append(cname, 0xc0, 0x11)
+	b = append(b, 0xc0, 0x0c, 0, 5, 0, 1, 0, 0, 0, 60, 0, byte(len(cname)))
+	b = append(b, cname...)
+
+	// cdn.example.com A 93.184.216.34, the name points to the CNAME data
+	b = append(b, 0xc0, byte(12+len(dnsName("evil", "example", "com"))+4+12), 0, 1, 0, 1, 0, 0, 0, 60, 0, 4)
+	return append(b, 93, 184, 216, 34)
+}()
+
+// Test_decodeDNS tests the decodeDNS function
+func Test_decodeDNS(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    dnsMessage
+		wantErr bool
+	}{
+		{
+			name:    "short header",
+			data:    dnsQuery[:5],
+			wantErr: true,
+		},
+		{
+			name: "query",
+			data: dnsQuery,
+			want: dnsMessage{id: 0x1234, questions: []dnsQuestion{{name: "evil.example.com", qtype: 1}}},
+		},
+		{
+			name: "response",
+			data: dnsResponse,
+			want: dnsMessage{
+				id:        0x1234,
+				response:  true,
+				questions: []dnsQuestion{{name: "evil.example.com", qtype: 1}},
+				answers: []dnsAnswer{
+					{name: "evil.example.com", rtype: 5, data: "cdn.example.com"},
+					{name: "cdn.example.com", rtype: 1, data: "93.184.216.34"},
+				},
+			},
+		},
+		{
+			name: "response truncated in the answers",
+			data: dnsResponse[:len(dnsResponse)-2],
+			want: dnsMessage{
+				id:        0x1234,
+				response:  true,
+				questions: []dnsQuestion{{name: "evil.example.com", qtype: 1}},
+				answers:   []dnsAnswer{{name: "evil.example.com", rtype: 5, data: "cdn.example.com"}},
+			},
+		},
+		{
+			name:    "truncated question",
+			data:    dnsQuery[:20],
+			wantErr: true,
+		},
+		{
+			name:    "name pointer loop",
+			data:    []byte{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0xc0, 0x0c, 0, 1, 0, 1},
+			wantErr: true,
+		},
+		{
+			name:    "not a query",
+			data:    []byte{0, 0, 0x28, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeDNS(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("decodeDNS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeDNS() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolutionCache_annotate tests the decoding of the DNS messages and the naming of the connections
+func TestResolutionCache_annotate(t *testing.T) {
+	rc := newResolutionCache(8)
+
+	// Task.Tgid holds the thread id: curl resolves the names on a thread of its own
+	curl := metaDataOf(1, 42, "curl")
+	curl.MetaData.Task.Tgid = 42
+	resolver := metaDataOf(1, 42, "curl")
+	resolver.MetaData.Task.Tgid = 44
+	other := metaDataOf(1, 43, "wget")
+	other.MetaData.Task.Tgid = 43
+
+	str := func(name, value string) arg {
+		return arg{Name: name, Value: value, TarianType: uint32(TDT_STR), LinuxType: "char *"}
+	}
+	// sockaddr returns the argument text and the raw value of an AF_INET address
+	sockaddr := func(ip string, port uint16) (string, []byte) {
+		addr := netip.MustParseAddr(ip)
+		raw := binary.BigEndian.AppendUint16(append([]byte{AF_INET}, addr.AsSlice()...), port)
+
+		return fmt.Sprintf("{Family:AF_INET Sa_addr:%s Sa_port:%d}", ip, port), raw
+	}
+	message := func(ip string, port uint16, data []byte) ([]arg, []any) {
+		addr, raw := sockaddr(ip, port)
+		return []arg{{Name: "return", Value: "64"}, {Name: "addr", Value: addr}, {Name: "data", Value: "..."}}, []any{int64(64), raw, data}
+	}
+	connect := func(fd int32, ip string, port uint16) ([]arg, []any) {
+		addr, raw := sockaddr(ip, port)
+		return []arg{{Name: "fd", Value: fmt.Sprint(fd)}, {Name: "uservaddr", Value: addr}}, []any{fd, raw}
+	}
+	// send returns a message sent without an address on the socket fd
+	send := func(fd int32, data []byte) ([]arg, []any) {
+		return []arg{{Name: "fd", Value: fmt.Sprint(fd)}, {Name: "addr", Value: "<nil>"}, {Name: "data", Value: "..."}}, []any{fd, []byte{0}, data}
+	}
+
+	local, localRaw := sockaddr("10.0.0.2", 40000)
+	remote, remoteRaw := sockaddr("93.184.216.34", 443)
+	established := []arg{{Name: "local", Value: local}, {Name: "remote", Value: remote}}
+	establishedValues := []any{localRaw, remoteRaw}
+
+	web, webValues := connect(3, "93.184.216.34", 443)
+	stub, stubValues := connect(5, "127.0.0.53", 53)
+	query, queryValues := message("127.0.0.53", 53, dnsQuery)
+	connectedQuery, connectedQueryValues := send(5, dnsQuery)
+	unknownQuery, unknownQueryValues := send(6, dnsQuery)
+	response, responseValues := message("127.0.0.53", 53, dnsResponse)
+	otherPort, otherPortValues := message("10.0.0.1", 5353, dnsResponse)
+
+	tests := []struct {
+		name     string
+		id       TarianEventsE
+		metaData TarianMetaData
+		args     []arg
+		values   []any
+		want     []arg
+	}{
+		{
+			name:     "connect before the resolution",
+			id:       TDE_SYSCALL_CONNECT_E,
+			metaData: curl,
+			args:     web,
+			values:   webValues,
+			want:     web,
+		},
+		{
+			name:     "connect to the resolver",
+			id:       TDE_SYSCALL_CONNECT_E,
+			metaData: resolver,
+			args:     stub,
+			values:   stubValues,
+			want:     stub,
+		},
+		{
+			name:     "query on the connected socket",
+			id:       TDE_SYSCALL_SENDTO_E,
+			metaData: resolver,
+			args:     connectedQuery,
+			values:   connectedQueryValues,
+			want:     append(connectedQuery[:3:3], str("dns_query", "evil.example.com"), str("dns_type", "A")),
+		},
+		{
+			name:     "query on a socket of unknown peer",
+			id:       TDE_SYSCALL_SENDMSG_E,
+			metaData: resolver,
+			args:     unknownQuery,
+			values:   unknownQueryValues,
+			want:     unknownQuery,
+		},
+		{
+			name:     "query",
+			id:       TDE_SYSCALL_SENDTO_E,
+			metaData: resolver,
+			args:     query,
+			values:   queryValues,
+			want:     append(query[:3:3], str("dns_query", "evil.example.com"), str("dns_type", "A")),
+		},
+		{
+			name:     "message of another port",
+			id:       TDE_SYSCALL_RECVFROM_R,
+			metaData: other,
+			args:     otherPort,
+			values:   otherPortValues,
+			want:     otherPort,
+		},
+		{
+			name:     "response",
+			id:       TDE_SYSCALL_RECVFROM_R,
+			metaData: resolver,
+			args:     response,
+			values:   responseValues,
+			want: append(response[:3:3],
+				str("dns_query", "evil.example.com"),
+				str("dns_type", "A"),
+				str("dns_rcode", "NOERROR"),
+				str("dns_answers", "evil.example.com CNAME cdn.example.com, cdn.example.com A 93.184.216.34"),
+			),
+		},
+		{
+			name:     "connect to the address resolved by another thread",
+			id:       TDE_SYSCALL_CONNECT_E,
+			metaData: curl,
+			args:     web,
+			values:   webValues,
+			want:     append(web[:2:2], str("hostname", "evil.example.com")),
+		},
+		{
+			name:     "tcp connection to the resolved address",
+			id:       TDE_CONNECTION_CONNECT,
+			metaData: curl,
+			args:     established,
+			values:   establishedValues,
+			want:     append(established[:2:2], str("hostname", "evil.example.com")),
+		},
+		{
+			name:     "connect of another process",
+			id:       TDE_SYSCALL_CONNECT_E,
+			metaData: other,
+			args:     web,
+			values:   webValues,
+			want:     web,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rc.annotate(tt.id, tt.metaData, tt.args, tt.values)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolutionCache.annotate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_decodeSockaddr tests the decoding of the raw socket addresses
+func Test_decodeSockaddr(t *testing.T) {
+	mapped := append([]byte{AF_INET6}, netip.MustParseAddr("::ffff:10.0.0.1").AsSlice()...)
+
+	tests := []struct {
+		name     string
+		raw      []byte
+		wantAddr netip.Addr
+		wantPort uint16
+		wantOk   bool
+	}{
+		{name: "ipv4", raw: []byte{AF_INET, 127, 0, 0, 53, 0, 53}, wantAddr: netip.MustParseAddr("127.0.0.53"), wantPort: 53, wantOk: true},
+		{name: "ipv4-mapped ipv6", raw: append(mapped, 0x01, 0xbb), wantAddr: netip.MustParseAddr("10.0.0.1"), wantPort: 443, wantOk: true},
+		{name: "truncated", raw: []byte{AF_INET, 127, 0, 0, 53}},
+		{name: "unspecified", raw: []byte{0}},
+		{name: "unix", raw: []byte{1, 4, 0, '/', 't', 'm', 'p'}},
+		{name: "empty", raw: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, port, ok := decodeSockaddr(tt.raw)
+			if addr != tt.wantAddr || port != tt.wantPort || ok != tt.wantOk {
+				t.Errorf("decodeSockaddr() = %v, %v, %v, want %v, %v, %v", addr, port, ok, tt.wantAddr, tt.wantPort, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,1236 @@
+// Code generated by eventgen from events.json; DO NOT EDIT.
+
+package eventparser
+
+const (
+	TDE_SYSCALL_EXECVE_E TarianEventsE = 2 // TDE_SYSCALL_EXECVE_E represents the start of an execve syscall
+	TDE_SYSCALL_EXECVE_R TarianEventsE = 3 // TDE_SYSCALL_EXECVE_R represents the return of an execve syscall
+
+	TDE_SYSCALL_EXECVEAT_E TarianEventsE = 4 // TDE_SYSCALL_EXECVEAT_E represents the start of an execveat syscall
+	TDE_SYSCALL_EXECVEAT_R TarianEventsE = 5 // TDE_SYSCALL_EXECVEAT_R represents the return of an execveat syscall
+
+	TDE_SYSCALL_CLONE_E TarianEventsE = 6 // TDE_SYSCALL_CLONE_E represents the start of a clone syscall
+	TDE_SYSCALL_CLONE_R TarianEventsE = 7 // TDE_SYSCALL_CLONE_R represents the return of a clone syscall
+
+	TDE_SYSCALL_CLOSE_E TarianEventsE = 8 // TDE_SYSCALL_CLOSE_E represents the start of a close syscall
+	TDE_SYSCALL_CLOSE_R TarianEventsE = 9 // TDE_SYSCALL_CLOSE_R represents the return of a close syscall
+
+	TDE_SYSCALL_READ_E TarianEventsE = 10 // TDE_SYSCALL_READ_E represents the start of a read syscall
+	TDE_SYSCALL_READ_R TarianEventsE = 11 // TDE_SYSCALL_READ_R represents the return of a read syscall
+
+	TDE_SYSCALL_WRITE_E TarianEventsE = 12 // TDE_SYSCALL_WRITE_E represents the start of a write syscall
+	TDE_SYSCALL_WRITE_R TarianEventsE = 13 // TDE_SYSCALL_WRITE_R represents the return of a write syscall
+
+	TDE_SYSCALL_OPEN_E TarianEventsE = 14 // TDE_SYSCALL_OPEN_E represents the start of an open syscall
+	TDE_SYSCALL_OPEN_R TarianEventsE = 15 // TDE_SYSCALL_OPEN_R represents the return of an open syscall
+
+	TDE_SYSCALL_READV_E TarianEventsE = 16 // TDE_SYSCALL_READV_E represents the start of a readv syscall
+	TDE_SYSCALL_READV_R TarianEventsE = 17 // TDE_SYSCALL_READV_R represents the return of a readv syscall
+
+	TDE_SYSCALL_WRITEV_E TarianEventsE = 18 // TDE_SYSCALL_WRITEV_E represents the start of a writev syscall
+	TDE_SYSCALL_WRITEV_R TarianEventsE = 19 // TDE_SYSCALL_WRITEV_R represents the return of a writev syscall
+
+	TDE_SYSCALL_OPENAT_E TarianEventsE = 20 // TDE_SYSCALL_OPENAT_E represents the start of an openat syscall
+	TDE_SYSCALL_OPENAT_R TarianEventsE = 21 // TDE_SYSCALL_OPENAT_R represents the return of an openat syscall
+
+	TDE_SYSCALL_OPENAT2_E TarianEventsE = 22 // TDE_SYSCALL_OPENAT2_E represents the start of an openat2 syscall
+	TDE_SYSCALL_OPENAT2_R TarianEventsE = 23 // TDE_SYSCALL_OPENAT2_R represents the return of an openat2 syscall
+
+	TDE_SYSCALL_LISTEN_E TarianEventsE = 24 // TDE_SYSCALL_LISTEN_E represents the start of a listen syscall
+	TDE_SYSCALL_LISTEN_R TarianEventsE = 25 // TDE_SYSCALL_LISTEN_R represents the return of a listen syscall
+
+	TDE_SYSCALL_SOCKET_E TarianEventsE = 26 // TDE_SYSCALL_SOCKET_E represents the start of a socket syscall
+	TDE_SYSCALL_SOCKET_R TarianEventsE = 27 // TDE_SYSCALL_SOCKET_R represents the return of a socket syscall
+
+	TDE_SYSCALL_ACCEPT_E TarianEventsE = 28 // TDE_SYSCALL_ACCEPT_E represents the start of an accept syscall
+	TDE_SYSCALL_ACCEPT_R TarianEventsE = 29 // TDE_SYSCALL_ACCEPT_R represents the return of an accept syscall
+
+	TDE_SYSCALL_BIND_E TarianEventsE = 30 // TDE_SYSCALL_BIND_E represents the start of a bind syscall
+	TDE_SYSCALL_BIND_R TarianEventsE = 31 // TDE_SYSCALL_BIND_R represents the return of a bind syscall
+
+	TDE_SYSCALL_CONNECT_E TarianEventsE = 32 // TDE_SYSCALL_CONNECT_E represents the start of a connect syscall
+	TDE_SYSCALL_CONNECT_R TarianEventsE = 33 // TDE_SYSCALL_CONNECT_R represents the return of a connect syscall
+
+	TDE_SYSCALL_UNLINK_E TarianEventsE = 34 // TDE_SYSCALL_UNLINK_E represents the start of an unlink syscall
+	TDE_SYSCALL_UNLINK_R TarianEventsE = 35 // TDE_SYSCALL_UNLINK_R represents the return of an unlink syscall
+
+	TDE_SYSCALL_UNLINKAT_E TarianEventsE = 36 // TDE_SYSCALL_UNLINKAT_E represents the start of an unlinkat syscall
+	TDE_SYSCALL_UNLINKAT_R TarianEventsE = 37 // TDE_SYSCALL_UNLINKAT_R represents the return of an unlinkat syscall
+
+	TDE_SYSCALL_RENAME_E TarianEventsE = 38 // TDE_SYSCALL_RENAME_E represents the start of a rename syscall
+	TDE_SYSCALL_RENAME_R TarianEventsE = 39 // TDE_SYSCALL_RENAME_R represents the return of a rename syscall
+
+	TDE_SYSCALL_RENAMEAT2_E TarianEventsE = 40 // TDE_SYSCALL_RENAMEAT2_E represents the start of a renameat2 syscall
+	TDE_SYSCALL_RENAMEAT2_R TarianEventsE = 41 // TDE_SYSCALL_RENAMEAT2_R represents the return of a renameat2 syscall
+
+	TDE_SYSCALL_CHMOD_E TarianEventsE = 42 // TDE_SYSCALL_CHMOD_E represents the start of a chmod syscall
+	TDE_SYSCALL_CHMOD_R TarianEventsE = 43 // TDE_SYSCALL_CHMOD_R represents the return of a chmod syscall
+
+	TDE_SYSCALL_FCHMODAT_E TarianEventsE = 44 // TDE_SYSCALL_FCHMODAT_E represents the start of a fchmodat syscall
+	TDE_SYSCALL_FCHMODAT_R TarianEventsE = 45 // TDE_SYSCALL_FCHMODAT_R represents the return of a fchmodat syscall
+
+	TDE_SYSCALL_CHOWN_E TarianEventsE = 46 // TDE_SYSCALL_CHOWN_E represents the start of a chown syscall
+	TDE_SYSCALL_CHOWN_R TarianEventsE = 47 // TDE_SYSCALL_CHOWN_R represents the return of a chown syscall
+
+	TDE_SYSCALL_FCHOWNAT_E TarianEventsE = 48 // TDE_SYSCALL_FCHOWNAT_E represents the start of a fchownat syscall
+	TDE_SYSCALL_FCHOWNAT_R TarianEventsE = 49 // TDE_SYSCALL_FCHOWNAT_R represents the return of a fchownat syscall
+
+	TDE_SYSCALL_TRUNCATE_E TarianEventsE = 50 // TDE_SYSCALL_TRUNCATE_E represents the start of a truncate syscall
+	TDE_SYSCALL_TRUNCATE_R TarianEventsE = 51 // TDE_SYSCALL_TRUNCATE_R represents the return of a truncate syscall
+
+	TDE_SYSCALL_FTRUNCATE_E TarianEventsE = 52 // TDE_SYSCALL_FTRUNCATE_E represents the start of a ftruncate syscall
+	TDE_SYSCALL_FTRUNCATE_R TarianEventsE = 53 // TDE_SYSCALL_FTRUNCATE_R represents the return of a ftruncate syscall
+
+	TDE_SYSCALL_LINK_E TarianEventsE = 54 // TDE_SYSCALL_LINK_E represents the start of a link syscall
+	TDE_SYSCALL_LINK_R TarianEventsE = 55 // TDE_SYSCALL_LINK_R represents the return of a link syscall
+
+	TDE_SYSCALL_LINKAT_E TarianEventsE = 56 // TDE_SYSCALL_LINKAT_E represents the start of a linkat syscall
+	TDE_SYSCALL_LINKAT_R TarianEventsE = 57 // TDE_SYSCALL_LINKAT_R represents the return of a linkat syscall
+
+	TDE_SYSCALL_SYMLINK_E TarianEventsE = 58 // TDE_SYSCALL_SYMLINK_E represents the start of a symlink syscall
+	TDE_SYSCALL_SYMLINK_R TarianEventsE = 59 // TDE_SYSCALL_SYMLINK_R represents the return of a symlink syscall
+
+	TDE_SYSCALL_SYMLINKAT_E TarianEventsE = 60 // TDE_SYSCALL_SYMLINKAT_E represents the start of a symlinkat syscall
+	TDE_SYSCALL_SYMLINKAT_R TarianEventsE = 61 // TDE_SYSCALL_SYMLINKAT_R represents the return of a symlinkat syscall
+
+	TDE_SYSCALL_SETUID_E TarianEventsE = 62 // TDE_SYSCALL_SETUID_E represents the start of a setuid syscall
+	TDE_SYSCALL_SETUID_R TarianEventsE = 63 // TDE_SYSCALL_SETUID_R represents the return of a setuid syscall
+
+	TDE_SYSCALL_SETGID_E TarianEventsE = 64 // TDE_SYSCALL_SETGID_E represents the start of a setgid syscall
+	TDE_SYSCALL_SETGID_R TarianEventsE = 65 // TDE_SYSCALL_SETGID_R represents the return of a setgid syscall
+
+	TDE_SYSCALL_SETREUID_E TarianEventsE = 66 // TDE_SYSCALL_SETREUID_E represents the start of a setreuid syscall
+	TDE_SYSCALL_SETREUID_R TarianEventsE = 67 // TDE_SYSCALL_SETREUID_R represents the return of a setreuid syscall
+
+	TDE_SYSCALL_SETRESUID_E TarianEventsE = 68 // TDE_SYSCALL_SETRESUID_E represents the start of a setresuid syscall
+	TDE_SYSCALL_SETRESUID_R TarianEventsE = 69 // TDE_SYSCALL_SETRESUID_R represents the return of a setresuid syscall
+
+	TDE_SYSCALL_SETRESGID_E TarianEventsE = 70 // TDE_SYSCALL_SETRESGID_E represents the start of a setresgid syscall
+	TDE_SYSCALL_SETRESGID_R TarianEventsE = 71 // TDE_SYSCALL_SETRESGID_R represents the return of a setresgid syscall
+
+	TDE_SYSCALL_SETFSUID_E TarianEventsE = 72 // TDE_SYSCALL_SETFSUID_E represents the start of a setfsuid syscall
+	TDE_SYSCALL_SETFSUID_R TarianEventsE = 73 // TDE_SYSCALL_SETFSUID_R represents the return of a setfsuid syscall
+
+	TDE_SYSCALL_CAPSET_E TarianEventsE = 74 // TDE_SYSCALL_CAPSET_E represents the start of a capset syscall
+	TDE_SYSCALL_CAPSET_R TarianEventsE = 75 // TDE_SYSCALL_CAPSET_R represents the return of a capset syscall
+
+	TDE_SYSCALL_SETNS_E TarianEventsE = 76 // TDE_SYSCALL_SETNS_E represents the start of a setns syscall
+	TDE_SYSCALL_SETNS_R TarianEventsE = 77 // TDE_SYSCALL_SETNS_R represents the return of a setns syscall
+
+	TDE_SYSCALL_UNSHARE_E TarianEventsE = 78 // TDE_SYSCALL_UNSHARE_E represents the start of an unshare syscall
+	TDE_SYSCALL_UNSHARE_R TarianEventsE = 79 // TDE_SYSCALL_UNSHARE_R represents the return of an unshare syscall
+
+	TDE_SYSCALL_MOUNT_E TarianEventsE = 80 // TDE_SYSCALL_MOUNT_E represents the start of a mount syscall
+	TDE_SYSCALL_MOUNT_R TarianEventsE = 81 // TDE_SYSCALL_MOUNT_R represents the return of a mount syscall
+
+	TDE_SYSCALL_UMOUNT_E TarianEventsE = 82 // TDE_SYSCALL_UMOUNT_E represents the start of an umount syscall
+	TDE_SYSCALL_UMOUNT_R TarianEventsE = 83 // TDE_SYSCALL_UMOUNT_R represents the return of an umount syscall
+
+	TDE_SYSCALL_PIVOT_ROOT_E TarianEventsE = 84 // TDE_SYSCALL_PIVOT_ROOT_E represents the start of a pivot_root syscall
+	TDE_SYSCALL_PIVOT_ROOT_R TarianEventsE = 85 // TDE_SYSCALL_PIVOT_ROOT_R represents the return of a pivot_root syscall
+
+	TDE_SYSCALL_CHROOT_E TarianEventsE = 86 // TDE_SYSCALL_CHROOT_E represents the start of a chroot syscall
+	TDE_SYSCALL_CHROOT_R TarianEventsE = 87 // TDE_SYSCALL_CHROOT_R represents the return of a chroot syscall
+
+	TDE_SYSCALL_INIT_MODULE_E TarianEventsE = 88 // TDE_SYSCALL_INIT_MODULE_E represents the start of an init_module syscall
+	TDE_SYSCALL_INIT_MODULE_R TarianEventsE = 89 // TDE_SYSCALL_INIT_MODULE_R represents the return of an init_module syscall
+
+	TDE_SYSCALL_FINIT_MODULE_E TarianEventsE = 90 // TDE_SYSCALL_FINIT_MODULE_E represents the start of a finit_module syscall
+	TDE_SYSCALL_FINIT_MODULE_R TarianEventsE = 91 // TDE_SYSCALL_FINIT_MODULE_R represents the return of a finit_module syscall
+
+	TDE_SYSCALL_DELETE_MODULE_E TarianEventsE = 92 // TDE_SYSCALL_DELETE_MODULE_E represents the start of a delete_module syscall
+	TDE_SYSCALL_DELETE_MODULE_R TarianEventsE = 93 // TDE_SYSCALL_DELETE_MODULE_R represents the return of a delete_module syscall
+
+	TDE_SYSCALL_BPF_E TarianEventsE = 94 // TDE_SYSCALL_BPF_E represents the start of a bpf syscall
+	TDE_SYSCALL_BPF_R TarianEventsE = 95 // TDE_SYSCALL_BPF_R represents the return of a bpf syscall
+
+	TDE_SYSCALL_PTRACE_E TarianEventsE = 96 // TDE_SYSCALL_PTRACE_E represents the start of a ptrace syscall
+	TDE_SYSCALL_PTRACE_R TarianEventsE = 97 // TDE_SYSCALL_PTRACE_R represents the return of a ptrace syscall
+
+	TDE_SYSCALL_PROCESS_VM_READV_E TarianEventsE = 98 // TDE_SYSCALL_PROCESS_VM_READV_E represents the start of a process_vm_readv syscall
+	TDE_SYSCALL_PROCESS_VM_READV_R TarianEventsE = 99 // TDE_SYSCALL_PROCESS_VM_READV_R represents the return of a process_vm_readv syscall
+
+	TDE_SYSCALL_PROCESS_VM_WRITEV_E TarianEventsE = 100 // TDE_SYSCALL_PROCESS_VM_WRITEV_E represents the start of a process_vm_writev syscall
+	TDE_SYSCALL_PROCESS_VM_WRITEV_R TarianEventsE = 101 // TDE_SYSCALL_PROCESS_VM_WRITEV_R represents the return of a process_vm_writev syscall
+
+	TDE_SYSCALL_MEMFD_CREATE_E TarianEventsE = 102 // TDE_SYSCALL_MEMFD_CREATE_E represents the start of a memfd_create syscall
+	TDE_SYSCALL_MEMFD_CREATE_R TarianEventsE = 103 // TDE_SYSCALL_MEMFD_CREATE_R represents the return of a memfd_create syscall
+
+	TDE_SYSCALL_MMAP_E TarianEventsE = 104 // TDE_SYSCALL_MMAP_E represents the start of a mmap syscall
+	TDE_SYSCALL_MMAP_R TarianEventsE = 105 // TDE_SYSCALL_MMAP_R represents the return of a mmap syscall
+
+	TDE_SYSCALL_MPROTECT_E TarianEventsE = 106 // TDE_SYSCALL_MPROTECT_E represents the start of a mprotect syscall
+	TDE_SYSCALL_MPROTECT_R TarianEventsE = 107 // TDE_SYSCALL_MPROTECT_R represents the return of a mprotect syscall
+
+	TDE_SYSCALL_CLONE3_E TarianEventsE = 108 // TDE_SYSCALL_CLONE3_E represents the start of a clone3 syscall
+	TDE_SYSCALL_CLONE3_R TarianEventsE = 109 // TDE_SYSCALL_CLONE3_R represents the return of a clone3 syscall
+
+	TDE_SYSCALL_FORK_E TarianEventsE = 110 // TDE_SYSCALL_FORK_E represents the start of a fork syscall
+	TDE_SYSCALL_FORK_R TarianEventsE = 111 // TDE_SYSCALL_FORK_R represents the return of a fork syscall
+
+	TDE_SYSCALL_VFORK_E TarianEventsE = 112 // TDE_SYSCALL_VFORK_E represents the start of a vfork syscall
+	TDE_SYSCALL_VFORK_R TarianEventsE = 113 // TDE_SYSCALL_VFORK_R represents the return of a vfork syscall
+
+	TDE_SYSCALL_SENDTO_E TarianEventsE = 114 // TDE_SYSCALL_SENDTO_E represents the start of a sendto syscall
+	TDE_SYSCALL_SENDTO_R TarianEventsE = 115 // TDE_SYSCALL_SENDTO_R represents the return of a sendto syscall
+
+	TDE_SYSCALL_RECVFROM_E TarianEventsE = 116 // TDE_SYSCALL_RECVFROM_E represents the start of a recvfrom syscall
+	TDE_SYSCALL_RECVFROM_R TarianEventsE = 117 // TDE_SYSCALL_RECVFROM_R represents the return of a recvfrom syscall
+
+	TDE_SYSCALL_SENDMSG_E TarianEventsE = 118 // TDE_SYSCALL_SENDMSG_E represents the start of a sendmsg syscall
+	TDE_SYSCALL_SENDMSG_R TarianEventsE = 119 // TDE_SYSCALL_SENDMSG_R represents the return of a sendmsg syscall
+
+	TDE_SYSCALL_RECVMSG_E TarianEventsE = 120 // TDE_SYSCALL_RECVMSG_E represents the start of a recvmsg syscall
+	TDE_SYSCALL_RECVMSG_R TarianEventsE = 121 // TDE_SYSCALL_RECVMSG_R represents the return of a recvmsg syscall
+
+	TDE_COMMIT_CREDS        TarianEventsE = 122 // TDE_COMMIT_CREDS represents a commit_creds event
+	TDE_DO_INIT_MODULE      TarianEventsE = 123 // TDE_DO_INIT_MODULE represents a do_init_module event
+	TDE_TLS_WRITE           TarianEventsE = 124 // TDE_TLS_WRITE represents a tls_write event
+	TDE_TLS_READ            TarianEventsE = 125 // TDE_TLS_READ represents a tls_read event
+	TDE_BPRM_CHECK_SECURITY TarianEventsE = 126 // TDE_BPRM_CHECK_SECURITY represents a bprm_check_security event
+	TDE_FILE_OPEN           TarianEventsE = 127 // TDE_FILE_OPEN represents a file_open event
+	TDE_SOCKET_CONNECT      TarianEventsE = 128 // TDE_SOCKET_CONNECT represents a socket_connect event
+	TDE_CGROUP_CONNECT      TarianEventsE = 129 // TDE_CGROUP_CONNECT represents a cgroup_connect event
+	TDE_CGROUP_SENDMSG      TarianEventsE = 130 // TDE_CGROUP_SENDMSG represents a cgroup_sendmsg event
+	TDE_CGROUP_SOCK_CREATE  TarianEventsE = 131 // TDE_CGROUP_SOCK_CREATE represents a cgroup_sock_create event
+	TDE_CONNECTION_CONNECT  TarianEventsE = 132 // TDE_CONNECTION_CONNECT represents a connection_connect event
+	TDE_CONNECTION_ACCEPT   TarianEventsE = 133 // TDE_CONNECTION_ACCEPT represents a connection_accept event
+	TDE_CONNECTION_STATE    TarianEventsE = 134 // TDE_CONNECTION_STATE represents a connection_state event
+	TDE_CONNECTION_CLOSE    TarianEventsE = 135 // TDE_CONNECTION_CLOSE represents a connection_close event
+)
+
+// syscallTable maps the name of every syscall to its number on each supported architecture.
+var syscallTable = map[string]map[string]int{
+	"amd64": {
+		"execve":            59,
+		"execveat":          322,
+		"clone":             56,
+		"close":             3,
+		"read":              0,
+		"write":             1,
+		"open":              2,
+		"readv":             19,
+		"writev":            20,
+		"openat":            257,
+		"openat2":           437,
+		"listen":            50,
+		"socket":            41,
+		"accept":            43,
+		"bind":              49,
+		"connect":           42,
+		"unlink":            87,
+		"unlinkat":          263,
+		"rename":            82,
+		"renameat2":         316,
+		"chmod":             90,
+		"fchmodat":          268,
+		"chown":             92,
+		"fchownat":          260,
+		"truncate":          76,
+		"ftruncate":         77,
+		"link":              86,
+		"linkat":            265,
+		"symlink":           88,
+		"symlinkat":         266,
+		"setuid":            105,
+		"setgid":            106,
+		"setreuid":          113,
+		"setresuid":         117,
+		"setresgid":         119,
+		"setfsuid":          122,
+		"capset":            126,
+		"setns":             308,
+		"unshare":           272,
+		"mount":             165,
+		"umount":            166,
+		"pivot_root":        155,
+		"chroot":            161,
+		"init_module":       175,
+		"finit_module":      313,
+		"delete_module":     176,
+		"bpf":               321,
+		"ptrace":            101,
+		"process_vm_readv":  310,
+		"process_vm_writev": 311,
+		"memfd_create":      319,
+		"mmap":              9,
+		"mprotect":          10,
+		"clone3":            435,
+		"fork":              57,
+		"vfork":             58,
+		"sendto":            44,
+		"recvfrom":          45,
+		"sendmsg":           46,
+		"recvmsg":           47,
+	},
+	"arm64": {
+		"execve":            221,
+		"execveat":          281,
+		"clone":             220,
+		"close":             57,
+		"read":              63,
+		"write":             64,
+		"readv":             65,
+		"writev":            66,
+		"openat":            56,
+		"openat2":           437,
+		"listen":            201,
+		"socket":            198,
+		"accept":            202,
+		"bind":              200,
+		"connect":           203,
+		"unlinkat":          35,
+		"renameat2":         276,
+		"fchmodat":          53,
+		"fchownat":          54,
+		"truncate":          45,
+		"ftruncate":         46,
+		"linkat":            37,
+		"symlinkat":         36,
+		"setuid":            146,
+		"setgid":            144,
+		"setreuid":          145,
+		"setresuid":         147,
+		"setresgid":         149,
+		"setfsuid":          151,
+		"capset":            91,
+		"setns":             268,
+		"unshare":           97,
+		"mount":             40,
+		"umount":            39,
+		"pivot_root":        41,
+		"chroot":            51,
+		"init_module":       105,
+		"finit_module":      273,
+		"delete_module":     106,
+		"bpf":               280,
+		"ptrace":            117,
+		"process_vm_readv":  270,
+		"process_vm_writev": 271,
+		"memfd_create":      279,
+		"mmap":              222,
+		"mprotect":          226,
+		"clone3":            435,
+		"sendto":            206,
+		"recvfrom":          207,
+		"sendmsg":           211,
+		"recvmsg":           212,
+	},
+}
+
+// GenerateTarianEvents creates and returns a TarianEventMap
+func GenerateTarianEvents() TarianEventMap {
+	events := make(TarianEventMap)
+
+	execve_e := NewTarianEvent(SyscallId("execve"), "sys_execve_entry", 8957,
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "argv", paramType: TDT_STR_ARR, linuxType: "const char **"},
+		Param{name: "envp", paramType: TDT_STR_ARR, linuxType: "const char **"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_EXECVE_E, execve_e)
+
+	execve_r := NewTarianEvent(SyscallId("execve"), "sys_execve_exit", 4863,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "memfd", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_EXECVE_R, execve_r)
+
+	execveat_e := NewTarianEvent(SyscallId("execveat"), "sys_execveat_entry", 8965,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "argv", paramType: TDT_STR_ARR, linuxType: "char const **"},
+		Param{name: "envp", paramType: TDT_STR_ARR, linuxType: "char const **"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseExecveatFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_EXECVEAT_E, execveat_e)
+
+	execveat_r := NewTarianEvent(SyscallId("execveat"), "sys_execveat_exit", 4863,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "memfd", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_EXECVEAT_R, execveat_r)
+
+	clone_e := NewTarianEvent(SyscallId("clone"), "sys_clone_entry", 793,
+		Param{name: "clone_flags", paramType: TDT_U64, linuxType: "unsigned long", function: parseCloneFlags},
+		Param{name: "newsp", paramType: TDT_S64, linuxType: "unsigned long"},
+		Param{name: "parent_tid", paramType: TDT_S32, linuxType: "int *"},
+		Param{name: "child_tid", paramType: TDT_S32, linuxType: "int *"},
+		Param{name: "tls", paramType: TDT_S64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLONE_E, clone_e)
+
+	clone_r := NewTarianEvent(SyscallId("clone"), "sys_clone_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLONE_R, clone_r)
+
+	close_e := NewTarianEvent(SyscallId("close"), "sys_close_entry", 765,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLOSE_E, close_e)
+
+	close_r := NewTarianEvent(SyscallId("close"), "sys_close_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLOSE_R, close_r)
+
+	read_e := NewTarianEvent(SyscallId("read"), "sys_read_entry", 4867,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "buf", paramType: TDT_BYTE_ARR, linuxType: "char *"},
+		Param{name: "count", paramType: TDT_U32, linuxType: "size_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_READ_E, read_e)
+
+	read_r := NewTarianEvent(SyscallId("read"), "sys_read_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_READ_R, read_r)
+
+	write_e := NewTarianEvent(SyscallId("write"), "sys_write_entry", 4867,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "buf", paramType: TDT_BYTE_ARR, linuxType: "const char *"},
+		Param{name: "count", paramType: TDT_U32, linuxType: "size_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_WRITE_E, write_e)
+
+	write_r := NewTarianEvent(SyscallId("write"), "sys_write_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_WRITE_R, write_r)
+
+	open_e := NewTarianEvent(SyscallId("open"), "sys_open_entry", 4867,
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseOpenFlags},
+		Param{name: "mode", paramType: TDT_U32, linuxType: "umode_t", function: parseOpenMode},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPEN_E, open_e)
+
+	open_r := NewTarianEvent(SyscallId("open"), "sys_open_exit", 765,
+		Param{name: "return", paramType: TDT_U32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPEN_R, open_r)
+
+	readv_e := NewTarianEvent(SyscallId("readv"), "sys_readv_entry", 4867,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "vec", paramType: TDT_BYTE_ARR, linuxType: "const struct iovec *"},
+		Param{name: "vlen", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_READV_E, readv_e)
+
+	readv_r := NewTarianEvent(SyscallId("readv"), "sys_readv_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_READV_R, readv_r)
+
+	writev_e := NewTarianEvent(SyscallId("writev"), "sys_writev_entry", 4867,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "vec", paramType: TDT_BYTE_ARR, linuxType: "const struct iovec *"},
+		Param{name: "vlen", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_WRITEV_E, writev_e)
+
+	writev_r := NewTarianEvent(SyscallId("writev"), "sys_writev_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_WRITEV_R, writev_r)
+
+	openat_e := NewTarianEvent(SyscallId("openat"), "sys_openat_entry", 4871,
+		Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseOpenFlags},
+		Param{name: "mode", paramType: TDT_U32, linuxType: "umode_t", function: parseOpenMode},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPENAT_E, openat_e)
+
+	openat_r := NewTarianEvent(SyscallId("openat"), "sys_openat_exit", 765,
+		Param{name: "return", paramType: TDT_U32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPENAT_R, openat_r)
+
+	openat2_e := NewTarianEvent(SyscallId("openat2"), "sys_openat2_entry", 4891,
+		Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S64, linuxType: "unsigned long", function: parseOpenat2Flags},
+		Param{name: "mode", paramType: TDT_S64, linuxType: "unsigned long", function: parseOpenat2Mode},
+		Param{name: "resolve", paramType: TDT_S64, linuxType: "unsigned long", function: parseOpenat2Resolve},
+		Param{name: "usize", paramType: TDT_S32, linuxType: "size_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPENAT2_E, openat2_e)
+
+	openat2_r := NewTarianEvent(SyscallId("openat2"), "sys_openat2_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_OPENAT2_R, openat2_r)
+
+	listen_e := NewTarianEvent(SyscallId("listen"), "sys_listen_entry", 769,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "backlog", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LISTEN_E, listen_e)
+
+	listen_r := NewTarianEvent(SyscallId("listen"), "sys_listen_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LISTEN_R, listen_r)
+
+	socket_e := NewTarianEvent(SyscallId("socket"), "sys_socket_entry", 773,
+		Param{name: "family", paramType: TDT_S32, linuxType: "int", function: parseSocketFamily},
+		Param{name: "type", paramType: TDT_S32, linuxType: "int", function: parseSocketType},
+		Param{name: "protocol", paramType: TDT_S32, linuxType: "int", function: parseSocketProtocol},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SOCKET_E, socket_e)
+
+	socket_r := NewTarianEvent(SyscallId("socket"), "sys_socket_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SOCKET_R, socket_r)
+
+	accept_e := NewTarianEvent(SyscallId("accept"), "sys_accept_entry", 880,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "upeer_sockaddr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "upper_addrlen", paramType: TDT_S32, linuxType: "int *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_ACCEPT_E, accept_e)
+
+	accept_r := NewTarianEvent(SyscallId("accept"), "sys_accept_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_ACCEPT_R, accept_r)
+
+	bind_e := NewTarianEvent(SyscallId("bind"), "sys_bind_entry", 880,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "umyaddr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "addrlen", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_BIND_E, bind_e)
+
+	bind_r := NewTarianEvent(SyscallId("bind"), "sys_bind_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_BIND_R, bind_r)
+
+	connect_e := NewTarianEvent(SyscallId("connect"), "sys_connect_entry", 880,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uservaddr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "addrlen", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CONNECT_E, connect_e)
+
+	connect_r := NewTarianEvent(SyscallId("connect"), "sys_connect_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CONNECT_R, connect_r)
+
+	unlink_e := NewTarianEvent(SyscallId("unlink"), "sys_unlink_entry", 4859,
+		Param{name: "pathname", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNLINK_E, unlink_e)
+
+	unlink_r := NewTarianEvent(SyscallId("unlink"), "sys_unlink_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNLINK_R, unlink_r)
+
+	unlinkat_e := NewTarianEvent(SyscallId("unlinkat"), "sys_unlinkat_entry", 4867,
+		Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "pathname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flag", paramType: TDT_S32, linuxType: "int", function: parseUnlinkatFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNLINKAT_E, unlinkat_e)
+
+	unlinkat_r := NewTarianEvent(SyscallId("unlinkat"), "sys_unlinkat_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNLINKAT_R, unlinkat_r)
+
+	rename_e := NewTarianEvent(SyscallId("rename"), "sys_rename_entry", 8957,
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RENAME_E, rename_e)
+
+	rename_r := NewTarianEvent(SyscallId("rename"), "sys_rename_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RENAME_R, rename_r)
+
+	renameat2_e := NewTarianEvent(SyscallId("renameat2"), "sys_renameat2_entry", 8969,
+		Param{name: "olddfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newdfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseRenameFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RENAMEAT2_E, renameat2_e)
+
+	renameat2_r := NewTarianEvent(SyscallId("renameat2"), "sys_renameat2_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RENAMEAT2_R, renameat2_r)
+
+	chmod_e := NewTarianEvent(SyscallId("chmod"), "sys_chmod_entry", 4863,
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "mode", paramType: TDT_U32, linuxType: "umode_t", function: parseFileMode},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHMOD_E, chmod_e)
+
+	chmod_r := NewTarianEvent(SyscallId("chmod"), "sys_chmod_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHMOD_R, chmod_r)
+
+	fchmodat_e := NewTarianEvent(SyscallId("fchmodat"), "sys_fchmodat_entry", 4867,
+		Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "mode", paramType: TDT_U32, linuxType: "umode_t", function: parseFileMode},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FCHMODAT_E, fchmodat_e)
+
+	fchmodat_r := NewTarianEvent(SyscallId("fchmodat"), "sys_fchmodat_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FCHMODAT_R, fchmodat_r)
+
+	chown_e := NewTarianEvent(SyscallId("chown"), "sys_chown_entry", 4867,
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "user", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "group", paramType: TDT_U32, linuxType: "gid_t", function: parseChownId},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHOWN_E, chown_e)
+
+	chown_r := NewTarianEvent(SyscallId("chown"), "sys_chown_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHOWN_R, chown_r)
+
+	fchownat_e := NewTarianEvent(SyscallId("fchownat"), "sys_fchownat_entry", 4875,
+		Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "user", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "group", paramType: TDT_U32, linuxType: "gid_t", function: parseChownId},
+		Param{name: "flag", paramType: TDT_S32, linuxType: "int", function: parseFchownatFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FCHOWNAT_E, fchownat_e)
+
+	fchownat_r := NewTarianEvent(SyscallId("fchownat"), "sys_fchownat_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FCHOWNAT_R, fchownat_r)
+
+	truncate_e := NewTarianEvent(SyscallId("truncate"), "sys_truncate_entry", 4867,
+		Param{name: "path", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "length", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_TRUNCATE_E, truncate_e)
+
+	truncate_r := NewTarianEvent(SyscallId("truncate"), "sys_truncate_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_TRUNCATE_R, truncate_r)
+
+	ftruncate_e := NewTarianEvent(SyscallId("ftruncate"), "sys_ftruncate_entry", 773,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "unsigned int"},
+		Param{name: "length", paramType: TDT_S64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FTRUNCATE_E, ftruncate_e)
+
+	ftruncate_r := NewTarianEvent(SyscallId("ftruncate"), "sys_ftruncate_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FTRUNCATE_R, ftruncate_r)
+
+	link_e := NewTarianEvent(SyscallId("link"), "sys_link_entry", 8957,
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LINK_E, link_e)
+
+	link_r := NewTarianEvent(SyscallId("link"), "sys_link_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LINK_R, link_r)
+
+	linkat_e := NewTarianEvent(SyscallId("linkat"), "sys_linkat_entry", 8969,
+		Param{name: "olddfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newdfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseLinkatFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LINKAT_E, linkat_e)
+
+	linkat_r := NewTarianEvent(SyscallId("linkat"), "sys_linkat_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_LINKAT_R, linkat_r)
+
+	symlink_e := NewTarianEvent(SyscallId("symlink"), "sys_symlink_entry", 8957,
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SYMLINK_E, symlink_e)
+
+	symlink_r := NewTarianEvent(SyscallId("symlink"), "sys_symlink_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SYMLINK_R, symlink_r)
+
+	symlinkat_e := NewTarianEvent(SyscallId("symlinkat"), "sys_symlinkat_entry", 8961,
+		Param{name: "oldname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "newdfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},
+		Param{name: "newname", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SYMLINKAT_E, symlinkat_e)
+
+	symlinkat_r := NewTarianEvent(SyscallId("symlinkat"), "sys_symlinkat_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SYMLINKAT_R, symlinkat_r)
+
+	setuid_e := NewTarianEvent(SyscallId("setuid"), "sys_setuid_entry", 781,
+		Param{name: "uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETUID_E, setuid_e)
+
+	setuid_r := NewTarianEvent(SyscallId("setuid"), "sys_setuid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETUID_R, setuid_r)
+
+	setgid_e := NewTarianEvent(SyscallId("setgid"), "sys_setgid_entry", 781,
+		Param{name: "gid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_gid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_egid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_sgid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_fsgid", paramType: TDT_U32, linuxType: "gid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETGID_E, setgid_e)
+
+	setgid_r := NewTarianEvent(SyscallId("setgid"), "sys_setgid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "gid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "egid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "sgid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "fsgid", paramType: TDT_U32, linuxType: "gid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETGID_R, setgid_r)
+
+	setreuid_e := NewTarianEvent(SyscallId("setreuid"), "sys_setreuid_entry", 785,
+		Param{name: "ruid", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "old_uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETREUID_E, setreuid_e)
+
+	setreuid_r := NewTarianEvent(SyscallId("setreuid"), "sys_setreuid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETREUID_R, setreuid_r)
+
+	setresuid_e := NewTarianEvent(SyscallId("setresuid"), "sys_setresuid_entry", 789,
+		Param{name: "ruid", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "suid", paramType: TDT_U32, linuxType: "uid_t", function: parseChownId},
+		Param{name: "old_uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETRESUID_E, setresuid_e)
+
+	setresuid_r := NewTarianEvent(SyscallId("setresuid"), "sys_setresuid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETRESUID_R, setresuid_r)
+
+	setresgid_e := NewTarianEvent(SyscallId("setresgid"), "sys_setresgid_entry", 789,
+		Param{name: "rgid", paramType: TDT_U32, linuxType: "gid_t", function: parseChownId},
+		Param{name: "egid", paramType: TDT_U32, linuxType: "gid_t", function: parseChownId},
+		Param{name: "sgid", paramType: TDT_U32, linuxType: "gid_t", function: parseChownId},
+		Param{name: "old_gid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_egid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_sgid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "old_fsgid", paramType: TDT_U32, linuxType: "gid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETRESGID_E, setresgid_e)
+
+	setresgid_r := NewTarianEvent(SyscallId("setresgid"), "sys_setresgid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "gid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "egid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "sgid", paramType: TDT_U32, linuxType: "gid_t"},
+		Param{name: "fsgid", paramType: TDT_U32, linuxType: "gid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETRESGID_R, setresgid_r)
+
+	setfsuid_e := NewTarianEvent(SyscallId("setfsuid"), "sys_setfsuid_entry", 781,
+		Param{name: "fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "old_fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETFSUID_E, setfsuid_e)
+
+	setfsuid_r := NewTarianEvent(SyscallId("setfsuid"), "sys_setfsuid_exit", 781,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "euid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "suid", paramType: TDT_U32, linuxType: "uid_t"},
+		Param{name: "fsuid", paramType: TDT_U32, linuxType: "uid_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETFSUID_R, setfsuid_r)
+
+	capset_e := NewTarianEvent(SyscallId("capset"), "sys_capset_entry", 789,
+		Param{name: "pid", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "old_effective", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+		Param{name: "old_permitted", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+		Param{name: "old_inheritable", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CAPSET_E, capset_e)
+
+	capset_r := NewTarianEvent(SyscallId("capset"), "sys_capset_exit", 789,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "effective", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+		Param{name: "permitted", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+		Param{name: "inheritable", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CAPSET_R, capset_r)
+
+	setns_e := NewTarianEvent(SyscallId("setns"), "sys_setns_entry", 797,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "nstype", paramType: TDT_S32, linuxType: "int", function: parseNamespaceType},
+		Param{name: "old_mnt_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_pid_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_net_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_uts_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_ipc_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_cgroup_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_user_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETNS_E, setns_e)
+
+	setns_r := NewTarianEvent(SyscallId("setns"), "sys_setns_exit", 793,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "mnt_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "pid_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "net_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "uts_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "ipc_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "cgroup_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "user_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SETNS_R, setns_r)
+
+	unshare_e := NewTarianEvent(SyscallId("unshare"), "sys_unshare_entry", 797,
+		Param{name: "unshare_flags", paramType: TDT_U64, linuxType: "unsigned long", function: parseCloneFlags},
+		Param{name: "old_mnt_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_pid_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_net_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_uts_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_ipc_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_cgroup_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "old_user_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNSHARE_E, unshare_e)
+
+	unshare_r := NewTarianEvent(SyscallId("unshare"), "sys_unshare_exit", 793,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "mnt_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "pid_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "net_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "uts_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "ipc_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "cgroup_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+		Param{name: "user_ns", paramType: TDT_U32, linuxType: "unsigned int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UNSHARE_R, unshare_r)
+
+	mount_e := NewTarianEvent(SyscallId("mount"), "sys_mount_entry", 13063,
+		Param{name: "dev_name", paramType: TDT_STR, linuxType: "char *"},
+		Param{name: "dir_name", paramType: TDT_STR, linuxType: "char *"},
+		Param{name: "type", paramType: TDT_STR, linuxType: "char *"},
+		Param{name: "flags", paramType: TDT_U64, linuxType: "unsigned long", function: parseMountFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MOUNT_E, mount_e)
+
+	mount_r := NewTarianEvent(SyscallId("mount"), "sys_mount_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MOUNT_R, mount_r)
+
+	umount_e := NewTarianEvent(SyscallId("umount"), "sys_umount_entry", 4863,
+		Param{name: "name", paramType: TDT_STR, linuxType: "char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseUmountFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UMOUNT_E, umount_e)
+
+	umount_r := NewTarianEvent(SyscallId("umount"), "sys_umount_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_UMOUNT_R, umount_r)
+
+	pivot_root_e := NewTarianEvent(SyscallId("pivot_root"), "sys_pivot_root_entry", 8957,
+		Param{name: "new_root", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "put_old", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PIVOT_ROOT_E, pivot_root_e)
+
+	pivot_root_r := NewTarianEvent(SyscallId("pivot_root"), "sys_pivot_root_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PIVOT_ROOT_R, pivot_root_r)
+
+	chroot_e := NewTarianEvent(SyscallId("chroot"), "sys_chroot_entry", 4859,
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHROOT_E, chroot_e)
+
+	chroot_r := NewTarianEvent(SyscallId("chroot"), "sys_chroot_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CHROOT_R, chroot_r)
+
+	init_module_e := NewTarianEvent(SyscallId("init_module"), "sys_init_module_entry", 4867,
+		Param{name: "len", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "uargs", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_INIT_MODULE_E, init_module_e)
+
+	init_module_r := NewTarianEvent(SyscallId("init_module"), "sys_init_module_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_INIT_MODULE_R, init_module_r)
+
+	finit_module_e := NewTarianEvent(SyscallId("finit_module"), "sys_finit_module_entry", 8965,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "uargs", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseFinitModuleFlags},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FINIT_MODULE_E, finit_module_e)
+
+	finit_module_r := NewTarianEvent(SyscallId("finit_module"), "sys_finit_module_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FINIT_MODULE_R, finit_module_r)
+
+	delete_module_e := NewTarianEvent(SyscallId("delete_module"), "sys_delete_module_entry", 4863,
+		Param{name: "name", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseDeleteModuleFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_DELETE_MODULE_E, delete_module_e)
+
+	delete_module_r := NewTarianEvent(SyscallId("delete_module"), "sys_delete_module_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_DELETE_MODULE_R, delete_module_r)
+
+	bpf_e := NewTarianEvent(SyscallId("bpf"), "sys_bpf_entry", 4871,
+		Param{name: "cmd", paramType: TDT_S32, linuxType: "int", function: parseBpfCmd},
+		Param{name: "prog_type", paramType: TDT_U32, linuxType: "enum bpf_prog_type", function: parseBpfProgType},
+		Param{name: "map_type", paramType: TDT_U32, linuxType: "enum bpf_map_type", function: parseBpfMapType},
+		Param{name: "name", paramType: TDT_STR, linuxType: "char *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_BPF_E, bpf_e)
+
+	bpf_r := NewTarianEvent(SyscallId("bpf"), "sys_bpf_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_BPF_R, bpf_r)
+
+	ptrace_e := NewTarianEvent(SyscallId("ptrace"), "sys_ptrace_entry", 789,
+		Param{name: "request", paramType: TDT_S64, linuxType: "long", function: parsePtraceRequest},
+		Param{name: "pid", paramType: TDT_S32, linuxType: "long"},
+		Param{name: "addr", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "data", paramType: TDT_U64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PTRACE_E, ptrace_e)
+
+	ptrace_r := NewTarianEvent(SyscallId("ptrace"), "sys_ptrace_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PTRACE_R, ptrace_r)
+
+	process_vm_readv_e := NewTarianEvent(SyscallId("process_vm_readv"), "sys_process_vm_readv_entry", 797,
+		Param{name: "pid", paramType: TDT_S32, linuxType: "pid_t"},
+		Param{name: "liovcnt", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "riovcnt", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "flags", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "len", paramType: TDT_U64, linuxType: "size_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PROCESS_VM_READV_E, process_vm_readv_e)
+
+	process_vm_readv_r := NewTarianEvent(SyscallId("process_vm_readv"), "sys_process_vm_readv_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PROCESS_VM_READV_R, process_vm_readv_r)
+
+	process_vm_writev_e := NewTarianEvent(SyscallId("process_vm_writev"), "sys_process_vm_writev_entry", 797,
+		Param{name: "pid", paramType: TDT_S32, linuxType: "pid_t"},
+		Param{name: "liovcnt", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "riovcnt", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "flags", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "len", paramType: TDT_U64, linuxType: "size_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PROCESS_VM_WRITEV_E, process_vm_writev_e)
+
+	process_vm_writev_r := NewTarianEvent(SyscallId("process_vm_writev"), "sys_process_vm_writev_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_PROCESS_VM_WRITEV_R, process_vm_writev_r)
+
+	memfd_create_e := NewTarianEvent(SyscallId("memfd_create"), "sys_memfd_create_entry", 4863,
+		Param{name: "uname", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseMemfdFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MEMFD_CREATE_E, memfd_create_e)
+
+	memfd_create_r := NewTarianEvent(SyscallId("memfd_create"), "sys_memfd_create_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MEMFD_CREATE_R, memfd_create_r)
+
+	mmap_e := NewTarianEvent(SyscallId("mmap"), "sys_mmap_entry", 797,
+		Param{name: "addr", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "len", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "prot", paramType: TDT_S32, linuxType: "int", function: parseProtFlags},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "int", function: parseMapFlags},
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "off", paramType: TDT_U64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MMAP_E, mmap_e)
+
+	mmap_r := NewTarianEvent(SyscallId("mmap"), "sys_mmap_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MMAP_R, mmap_r)
+
+	mprotect_e := NewTarianEvent(SyscallId("mprotect"), "sys_mprotect_entry", 781,
+		Param{name: "start", paramType: TDT_U64, linuxType: "unsigned long"},
+		Param{name: "len", paramType: TDT_U64, linuxType: "size_t"},
+		Param{name: "prot", paramType: TDT_S32, linuxType: "int", function: parseProtFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MPROTECT_E, mprotect_e)
+
+	mprotect_r := NewTarianEvent(SyscallId("mprotect"), "sys_mprotect_exit", 765,
+		Param{name: "return", paramType: TDT_S32, linuxType: "int"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_MPROTECT_R, mprotect_r)
+
+	clone3_e := NewTarianEvent(SyscallId("clone3"), "sys_clone3_entry", 825,
+		Param{name: "size", paramType: TDT_U64, linuxType: "size_t"},
+		Param{name: "flags", paramType: TDT_U64, linuxType: "u64", function: parseCloneFlags},
+		Param{name: "pidfd", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "exit_signal", paramType: TDT_U64, linuxType: "u64", function: parseExitSignal},
+		Param{name: "stack", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "stack_size", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "tls", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "cgroup", paramType: TDT_U64, linuxType: "u64"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLONE3_E, clone3_e)
+
+	clone3_r := NewTarianEvent(SyscallId("clone3"), "sys_clone3_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_CLONE3_R, clone3_r)
+
+	fork_e := NewTarianEvent(SyscallId("fork"), "sys_fork_entry", 769,
+		Param{name: "clone_flags", paramType: TDT_U64, linuxType: "unsigned long", function: parseCloneFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FORK_E, fork_e)
+
+	fork_r := NewTarianEvent(SyscallId("fork"), "sys_fork_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_FORK_R, fork_r)
+
+	vfork_e := NewTarianEvent(SyscallId("vfork"), "sys_vfork_entry", 769,
+		Param{name: "clone_flags", paramType: TDT_U64, linuxType: "unsigned long", function: parseCloneFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_VFORK_E, vfork_e)
+
+	vfork_r := NewTarianEvent(SyscallId("vfork"), "sys_vfork_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "long"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_VFORK_R, vfork_r)
+
+	sendto_e := NewTarianEvent(SyscallId("sendto"), "sys_sendto_entry", 1150,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "len", paramType: TDT_U64, linuxType: "size_t"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseMsgFlags},
+		Param{name: "addr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "addr_len", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "void *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SENDTO_E, sendto_e)
+
+	sendto_r := NewTarianEvent(SyscallId("sendto"), "sys_sendto_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SENDTO_R, sendto_r)
+
+	recvfrom_e := NewTarianEvent(SyscallId("recvfrom"), "sys_recvfrom_entry", 777,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "size", paramType: TDT_U64, linuxType: "size_t"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseMsgFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RECVFROM_E, recvfrom_e)
+
+	recvfrom_r := NewTarianEvent(SyscallId("recvfrom"), "sys_recvfrom_exit", 1138,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+		Param{name: "addr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "void *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RECVFROM_R, recvfrom_r)
+
+	sendmsg_e := NewTarianEvent(SyscallId("sendmsg"), "sys_sendmsg_entry", 1146,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseMsgFlags},
+		Param{name: "len", paramType: TDT_U64, linuxType: "size_t"},
+		Param{name: "addr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "void *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SENDMSG_E, sendmsg_e)
+
+	sendmsg_r := NewTarianEvent(SyscallId("sendmsg"), "sys_sendmsg_exit", 769,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_SENDMSG_R, sendmsg_r)
+
+	recvmsg_e := NewTarianEvent(SyscallId("recvmsg"), "sys_recvmsg_entry", 769,
+		Param{name: "fd", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "flags", paramType: TDT_U32, linuxType: "unsigned int", function: parseMsgFlags},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RECVMSG_E, recvmsg_e)
+
+	recvmsg_r := NewTarianEvent(SyscallId("recvmsg"), "sys_recvmsg_exit", 1138,
+		Param{name: "return", paramType: TDT_S64, linuxType: "ssize_t"},
+		Param{name: "addr", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "void *"},
+	)
+	events.AddTarianEvent(TDE_SYSCALL_RECVMSG_R, recvmsg_r)
+
+	commit_creds := NewTarianEvent(NoSyscall, "commit_creds", 777,
+		Param{name: "old_effective", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+		Param{name: "new_effective", paramType: TDT_U64, linuxType: "kernel_cap_t", function: parseCapabilities},
+	)
+	events.AddTarianEvent(TDE_COMMIT_CREDS, commit_creds)
+
+	do_init_module := NewTarianEvent(NoSyscall, "do_init_module", 8957,
+		Param{name: "name", paramType: TDT_STR, linuxType: "char *"},
+		Param{name: "args", paramType: TDT_STR, linuxType: "char *"},
+	)
+	events.AddTarianEvent(TDE_DO_INIT_MODULE, do_init_module)
+
+	tls_write := NewTarianEvent(NoSyscall, "tls_write", 4864,
+		Param{name: "library", paramType: TDT_U8, linuxType: "u8", function: parseTlsLibrary},
+		Param{name: "length", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "const void *"},
+	)
+	events.AddTarianEvent(TDE_TLS_WRITE, tls_write)
+
+	tls_read := NewTarianEvent(NoSyscall, "tls_read", 4864,
+		Param{name: "library", paramType: TDT_U8, linuxType: "u8", function: parseTlsLibrary},
+		Param{name: "length", paramType: TDT_S32, linuxType: "int"},
+		Param{name: "data", paramType: TDT_BYTE_ARR, linuxType: "void *"},
+	)
+	events.AddTarianEvent(TDE_TLS_READ, tls_read)
+
+	bprm_check_security := NewTarianEvent(NoSyscall, "bprm_check_security", 4872,
+		Param{name: "verdict", paramType: TDT_U8, linuxType: "u8", function: parseVerdict},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "dev", paramType: TDT_U32, linuxType: "dev_t"},
+		Param{name: "ino", paramType: TDT_U64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_BPRM_CHECK_SECURITY, bprm_check_security)
+
+	file_open := NewTarianEvent(NoSyscall, "file_open", 4876,
+		Param{name: "verdict", paramType: TDT_U8, linuxType: "u8", function: parseVerdict},
+		Param{name: "filename", paramType: TDT_STR, linuxType: "const char *"},
+		Param{name: "flags", paramType: TDT_S32, linuxType: "unsigned int", function: parseOpenFlags},
+		Param{name: "dev", paramType: TDT_U32, linuxType: "dev_t"},
+		Param{name: "ino", paramType: TDT_U64, linuxType: "unsigned long"},
+	)
+	events.AddTarianEvent(TDE_FILE_OPEN, file_open)
+
+	socket_connect := NewTarianEvent(NoSyscall, "socket_connect", 873,
+		Param{name: "verdict", paramType: TDT_U8, linuxType: "u8", function: parseVerdict},
+		Param{name: "address", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+	)
+	events.AddTarianEvent(TDE_SOCKET_CONNECT, socket_connect)
+
+	cgroup_connect := NewTarianEvent(NoSyscall, "cgroup_connect", 888,
+		Param{name: "cgroup_id", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "address", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "type", paramType: TDT_S32, linuxType: "u32", function: parseSocketType},
+		Param{name: "protocol", paramType: TDT_S32, linuxType: "u32", function: parseSocketProtocol},
+	)
+	events.AddTarianEvent(TDE_CGROUP_CONNECT, cgroup_connect)
+
+	cgroup_sendmsg := NewTarianEvent(NoSyscall, "cgroup_sendmsg", 888,
+		Param{name: "cgroup_id", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "address", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "type", paramType: TDT_S32, linuxType: "u32", function: parseSocketType},
+		Param{name: "protocol", paramType: TDT_S32, linuxType: "u32", function: parseSocketProtocol},
+	)
+	events.AddTarianEvent(TDE_CGROUP_SENDMSG, cgroup_sendmsg)
+
+	cgroup_sock_create := NewTarianEvent(NoSyscall, "cgroup_sock_create", 781,
+		Param{name: "cgroup_id", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "family", paramType: TDT_S32, linuxType: "u32", function: parseSocketFamily},
+		Param{name: "type", paramType: TDT_S32, linuxType: "u32", function: parseSocketType},
+		Param{name: "protocol", paramType: TDT_S32, linuxType: "u32", function: parseSocketProtocol},
+	)
+	events.AddTarianEvent(TDE_CGROUP_SOCK_CREATE, cgroup_sock_create)
+
+	connection_connect := NewTarianEvent(NoSyscall, "connection_connect", 983,
+		Param{name: "local", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "remote", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+	)
+	events.AddTarianEvent(TDE_CONNECTION_CONNECT, connection_connect)
+
+	connection_accept := NewTarianEvent(NoSyscall, "connection_accept", 983,
+		Param{name: "local", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "remote", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+	)
+	events.AddTarianEvent(TDE_CONNECTION_ACCEPT, connection_accept)
+
+	connection_state := NewTarianEvent(NoSyscall, "connection_state", 991,
+		Param{name: "local", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "remote", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "old_state", paramType: TDT_S32, linuxType: "int", function: parseTcpState},
+		Param{name: "new_state", paramType: TDT_S32, linuxType: "int", function: parseTcpState},
+	)
+	events.AddTarianEvent(TDE_CONNECTION_STATE, connection_state)
+
+	connection_close := NewTarianEvent(NoSyscall, "connection_close", 1003,
+		Param{name: "local", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "remote", paramType: TDT_SOCKADDR, linuxType: "struct sockaddr *"},
+		Param{name: "state", paramType: TDT_S32, linuxType: "int", function: parseTcpState},
+		Param{name: "bytes_sent", paramType: TDT_U64, linuxType: "u64"},
+		Param{name: "bytes_received", paramType: TDT_U64, linuxType: "u64"},
+	)
+	events.AddTarianEvent(TDE_CONNECTION_CLOSE, connection_close)
+
+	return events
+}
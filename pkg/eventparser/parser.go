@@ -22,6 +22,7 @@ type ByteStream struct {
 	data     []byte // data is the array of bytes in the stream
 	position int    // position is the current position in the stream
 	nparams  uint8  // nparams is the number of parameters
+	values   []any  // values holds the raw values of the parsed parameters, in the order of the arguments, see parseParam
 }
 
 // NewByteStream creates a new ByteStream with the given input data and n parameters.
@@ -71,7 +72,8 @@ func ParseByteArray(data []byte) (map[string]any, error) {
 		return nil, parserErr.Throwf("%v", err)
 	}
 
-	record["context"] = ps
+	ps = processes.annotateTarget(TarianEventsE(eventId), metaData, ps)
+	record["context"] = resolutions.annotate(TarianEventsE(eventId), metaData, ps, bs.values)
 
 	return record, nil
 }
@@ -108,7 +110,25 @@ func (bs *ByteStream) parseParams(event TarianEvent) ([]arg, error) {
 }
 
 // parseParam parses the given parameter based on its type and returns the parsed value.
+// The raw value of a socket address, which is parsed as text, is its encoding in the event.
 func (bs *ByteStream) parseParam(p Param) (arg, error) {
+	start := bs.position
+	pVal, err := bs.parseValue(p)
+	if err != nil {
+		return arg{}, parserErr.Throwf("%v", err)
+	}
+
+	raw := pVal
+	if p.paramType == TDT_SOCKADDR {
+		raw = bs.data[start:bs.position]
+	}
+
+	bs.values = append(bs.values, raw)
+	return p.processValue(pVal)
+}
+
+// parseValue reads the raw value of the given parameter based on its type.
+func (bs *ByteStream) parseValue(p Param) (any, error) {
 	var pVal any
 	var err error
 
@@ -139,10 +159,10 @@ func (bs *ByteStream) parseParam(p Param) (arg, error) {
 	}
 
 	if err != nil {
-		return arg{}, parserErr.Throwf("%v", err)
+		return nil, parserErr.Throwf("%v", err)
 	}
 
-	return p.processValue(pVal)
+	return pVal, nil
 }
 
 // parseUint8 reads an 8-bit unsigned integer from the ByteStream and returns it.
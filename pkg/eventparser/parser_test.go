@@ -512,6 +512,28 @@ func TestByteStream_parseParams(t *testing.T) {
 	}
 }
 
+// TestByteStream_parseParams_values tests that the raw values of the parameters are kept
+func TestByteStream_parseParams_values(t *testing.T) {
+	bs := NewByteStream([]byte{7, 0, 0, 0, 3, 0, 1, 0, 2, AF_INET, 127, 0, 0, 53, 0, 53}, 3)
+	event := TarianEvent{
+		name: "test",
+		params: []Param{
+			{name: "fd", paramType: TDT_S32},
+			{name: "data", paramType: TDT_BYTE_ARR},
+			{name: "addr", paramType: TDT_SOCKADDR},
+		},
+	}
+
+	if _, err := bs.parseParams(event); err != nil {
+		t.Fatalf("ByteStream.parseParams() error = %v", err)
+	}
+
+	want := []any{int32(7), []byte{1, 0, 2}, []byte{AF_INET, 127, 0, 0, 53, 0, 53}}
+	if !reflect.DeepEqual(bs.values, want) {
+		t.Errorf("ByteStream.values = %v, want %v", bs.values, want)
+	}
+}
+
 // TestByteStream_parseParam tests the parseParam function.
 func TestByteStream_parseParam(t *testing.T) {
 	type args struct {
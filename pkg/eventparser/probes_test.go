@@ -19,8 +19,8 @@ func TestLoadTarianEvents(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			LoadTarianEvents()
 
-			if len(Events) != 32 {
-				t.Errorf("LoadTarianEvents() = %v, want %v", len(Events), 32)
+			if len(Events) != 134 {
+				t.Errorf("LoadTarianEvents() = %v, want %v", len(Events), 134)
 			}
 		})
 	}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import (
+	"strconv"
+
+	"github.com/intelops/tarian-detector/pkg/utils"
+)
+
+// maxProcesses is the number of processes whose name is remembered, the oldest are forgotten first.
+const maxProcesses = 16384
+
+// processKey identifies a process by its pid in a pid namespace, as seen by the processes of the namespace.
+type processKey struct {
+	pidNs uint64 // Id of the pid namespace
+	pid   uint32 // Process id in the pid namespace
+}
+
+// processCache remembers the names of the processes seen in the events, to name the
+// processes targeted by the events of other processes.
+type processCache struct {
+	*ringCache[processKey]
+}
+
+// processes is the cache of the processes seen by ParseByteArray.
+var processes = newProcessCache(maxProcesses)
+
+// targetPidParams maps the events targeting another process to the parameter holding its pid.
+var targetPidParams = map[TarianEventsE]string{
+	TDE_SYSCALL_PTRACE_E:            "pid",
+	TDE_SYSCALL_PROCESS_VM_READV_E:  "pid",
+	TDE_SYSCALL_PROCESS_VM_WRITEV_E: "pid",
+}
+
+// newProcessCache creates a process cache remembering up to size processes.
+func newProcessCache(size int) *processCache {
+	return &processCache{newRingCache[processKey](size)}
+}
+
+// annotateTarget records the process of the event and, if the event targets a known process of
+// the same pid namespace, appends its name to the arguments as target_comm.
+func (pc *processCache) annotateTarget(id TarianEventsE, metaData TarianMetaData, args []arg) []arg {
+	task := metaData.MetaData.Task
+	pc.remember(processKey{pidNs: task.PidNsId, pid: task.Pid}, utils.ToString(task.Comm[:], 0, len(task.Comm)))
+
+	name, ok := targetPidParams[id]
+	if !ok {
+		return args
+	}
+
+	for _, a := range args {
+		if a.Name != name {
+			continue
+		}
+
+		pid, err := strconv.ParseUint(a.Value, 10, 32)
+		if err != nil || pid == 0 {
+			return args
+		}
+
+		comm, ok := pc.lookup(processKey{pidNs: task.PidNsId, pid: uint32(pid)})
+		if !ok {
+			return args
+		}
+
+		return append(args, arg{Name: "target_comm", Value: comm, TarianType: uint32(TDT_STR), LinuxType: "char *"})
+	}
+
+	return args
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+// metaDataOf returns the metadata of an event of the process pid in the pid namespace pidNs.
+func metaDataOf(pidNs uint64, pid uint32, comm string) TarianMetaData {
+	var m TarianMetaData
+	m.MetaData.Task.PidNsId = pidNs
+	m.MetaData.Task.Pid = pid
+	copy(m.MetaData.Task.Comm[:], comm)
+
+	return m
+}
+
+// TestProcessCache_annotateTarget tests the naming of the processes targeted by an event
+func TestProcessCache_annotateTarget(t *testing.T) {
+	pc := newProcessCache(8)
+	pc.annotateTarget(TDE_SYSCALL_CLOSE_E, metaDataOf(1, 42, "sshd"), nil)
+	pc.annotateTarget(TDE_SYSCALL_CLOSE_E, metaDataOf(2, 42, "nginx"), nil)
+
+	target := func(pid string) []arg {
+		return []arg{{Name: "request", Value: "PTRACE_ATTACH"}, {Name: "pid", Value: pid}}
+	}
+	comm := arg{Name: "target_comm", Value: "sshd", TarianType: uint32(TDT_STR), LinuxType: "char *"}
+
+	tests := []struct {
+		name     string
+		id       TarianEventsE
+		metaData TarianMetaData
+		args     []arg
+		want     []arg
+	}{
+		{
+			name:     "known target",
+			id:       TDE_SYSCALL_PTRACE_E,
+			metaData: metaDataOf(1, 7, "gdb"),
+			args:     target("42"),
+			want:     append(target("42"), comm),
+		},
+		{
+			name:     "unknown target",
+			id:       TDE_SYSCALL_PROCESS_VM_READV_E,
+			metaData: metaDataOf(1, 7, "gdb"),
+			args:     target("43"),
+			want:     target("43"),
+		},
+		{
+			name:     "target in another pid namespace",
+			id:       TDE_SYSCALL_PROCESS_VM_WRITEV_E,
+			metaData: metaDataOf(3, 7, "gdb"),
+			args:     target("42"),
+			want:     target("42"),
+		},
+		{
+			name:     "event without target",
+			id:       TDE_SYSCALL_CLOSE_E,
+			metaData: metaDataOf(1, 7, "gdb"),
+			args:     target("42"),
+			want:     target("42"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pc.annotateTarget(tt.id, tt.metaData, tt.args)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("processCache.annotateTarget() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProcessCache_remember tests that the oldest processes are forgotten first
+func TestProcessCache_remember(t *testing.T) {
+	pc := newProcessCache(2)
+	pc.remember(processKey{pidNs: 1, pid: 1}, "init")
+	pc.remember(processKey{pidNs: 1, pid: 2}, "kthreadd")
+	pc.remember(processKey{pidNs: 1, pid: 1}, "systemd")
+	pc.remember(processKey{pidNs: 1, pid: 3}, "sshd")
+
+	if _, ok := pc.lookup(processKey{pidNs: 1, pid: 1}); ok {
+		t.Errorf("processCache.lookup() found the oldest process")
+	}
+
+	for key, want := range map[processKey]string{{pidNs: 1, pid: 2}: "kthreadd", {pidNs: 1, pid: 3}: "sshd"} {
+		if got, ok := pc.lookup(key); !ok || got != want {
+			t.Errorf("processCache.lookup(%+v) = %v, %v, want %v", key, got, ok, want)
+		}
+	}
+}
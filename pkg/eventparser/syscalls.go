@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import "runtime"
+
+// NoSyscall is the syscall number reported by the events captured outside of syscalls.
+const NoSyscall = -1
+
+// Arch is the architecture whose syscall numbers are reported in the events, named after GOARCH.
+var Arch = runtime.GOARCH
+
+// SyscallId returns the number of the named syscall on the architecture the detector runs on.
+// It returns NoSyscall if the syscall does not exist on that architecture.
+func SyscallId(name string) int {
+	return ArchSyscallId(Arch, name)
+}
+
+// ArchSyscallId returns the number of the named syscall on the given architecture.
+// It returns NoSyscall if the architecture or the syscall is unknown.
+func ArchSyscallId(arch, name string) int {
+	id, ok := syscallTable[arch][name]
+	if !ok {
+		return NoSyscall
+	}
+
+	return id
+}
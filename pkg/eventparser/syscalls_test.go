@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package eventparser
+
+import "testing"
+
+// TestArchSyscallId tests the ArchSyscallId function
+func TestArchSyscallId(t *testing.T) {
+	tests := []struct {
+		name    string
+		arch    string
+		syscall string
+		want    int
+	}{
+		{name: "amd64 execve", arch: "amd64", syscall: "execve", want: 59},
+		{name: "arm64 execve", arch: "arm64", syscall: "execve", want: 221},
+		{name: "amd64 read", arch: "amd64", syscall: "read", want: 0},
+		{name: "arm64 open", arch: "arm64", syscall: "open", want: -1},
+		{name: "unknown syscall", arch: "amd64", syscall: "unknown", want: -1},
+		{name: "unknown arch", arch: "mips", syscall: "execve", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ArchSyscallId(tt.arch, tt.syscall); got != tt.want {
+				t.Errorf("ArchSyscallId() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
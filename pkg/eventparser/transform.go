@@ -78,6 +78,162 @@ func parseExecveatFlags(flag any) (string, error) {
 	return strings.Join(fs, "|"), nil
 }
 
+// unlinkatFlag represents the flags of unlinkat.
+var unlinkatFlag = []struct {
+	flag int32
+	name string
+}{
+	{AT_REMOVEDIR, "AT_REMOVEDIR"}, // Remove a directory
+}
+
+// parseUnlinkatFlags parses the given flag value and returns a string representation
+// of the corresponding flags based on the unlinkatFlag definitions.
+func parseUnlinkatFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseUnlinkatFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, unlinkatFlag), nil
+}
+
+// fchownatFlag represents the flags of fchownat.
+var fchownatFlag = []struct {
+	flag int32
+	name string
+}{
+	{AT_SYMLINK_NOFOLLOW, "AT_SYMLINK_NOFOLLOW"}, // Change the owner of the symbolic link itself
+	{AT_EMPTY_PATH, "AT_EMPTY_PATH"},             // Change the owner of the file referred to by dfd
+}
+
+// parseFchownatFlags parses the given flag value and returns a string representation
+// of the corresponding flags based on the fchownatFlag definitions.
+func parseFchownatFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseFchownatFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, fchownatFlag), nil
+}
+
+// linkatFlag represents the flags of linkat.
+var linkatFlag = []struct {
+	flag int32
+	name string
+}{
+	{AT_SYMLINK_FOLLOW, "AT_SYMLINK_FOLLOW"}, // Link the target of oldname if it is a symbolic link
+	{AT_EMPTY_PATH, "AT_EMPTY_PATH"},         // Link the file referred to by olddfd
+}
+
+// parseLinkatFlags parses the given flag value and returns a string representation
+// of the corresponding flags based on the linkatFlag definitions.
+func parseLinkatFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseLinkatFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, linkatFlag), nil
+}
+
+// Constants representing the flags of renameat2.
+const (
+	RENAME_NOREPLACE = 1 << 0 // Do not overwrite the new name.
+	RENAME_EXCHANGE  = 1 << 1 // Atomically exchange the old and new names.
+	RENAME_WHITEOUT  = 1 << 2 // Leave a whiteout object at the old name.
+)
+
+// renameFlag represents the flags of renameat2.
+var renameFlag = []struct {
+	flag uint32
+	name string
+}{
+	{RENAME_NOREPLACE, "RENAME_NOREPLACE"},
+	{RENAME_EXCHANGE, "RENAME_EXCHANGE"},
+	{RENAME_WHITEOUT, "RENAME_WHITEOUT"},
+}
+
+// parseRenameFlags parses the given flags value and returns a string representation
+// of the corresponding flags based on the renameFlag definitions.
+func parseRenameFlags(flags any) (string, error) {
+	f, ok := flags.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", flags), transformErr.Throwf("parseRenameFlags: parse value error expected %T received %T", f, flags)
+	}
+
+	return joinFlags(f, renameFlag), nil
+}
+
+// Constants representing the special bits of a file mode.
+const (
+	S_ISUID = 04000 // Set user id on execution.
+	S_ISGID = 02000 // Set group id on execution.
+	S_ISVTX = 01000 // Sticky bit, restricted deletion in directories.
+)
+
+// fileModeBit represents the special bits of a file mode.
+var fileModeBit = []struct {
+	flag uint32
+	name string
+}{
+	{S_ISUID, "S_ISUID"},
+	{S_ISGID, "S_ISGID"},
+	{S_ISVTX, "S_ISVTX"},
+}
+
+// parseFileMode takes a file mode value and returns its special bits followed by
+// the octal representation of its permission bits, e.g. S_ISUID|0755.
+func parseFileMode(mode any) (string, error) {
+	m, ok := mode.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", mode), transformErr.Throwf("parseFileMode: parse value error expected %T received %T", m, mode)
+	}
+
+	var ms []string
+	for _, v := range fileModeBit {
+		if m&v.flag == v.flag {
+			ms = append(ms, v.name)
+		}
+	}
+
+	ms = append(ms, fmt.Sprintf("%04o", m&0777))
+	return strings.Join(ms, "|"), nil
+}
+
+// parseChownId takes a user or group id of chown or of the setre*id syscalls and returns it, or -1 if the id is left unchanged.
+func parseChownId(id any) (string, error) {
+	i, ok := id.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", id), transformErr.Throwf("parseChownId: parse value error expected %T received %T", i, id)
+	}
+
+	if i == ^uint32(0) {
+		return "-1", nil
+	}
+
+	return fmt.Sprintf("%v", i), nil
+}
+
+// joinFlags returns the names of the flags set in f joined by |, or the value of f if none is set.
+func joinFlags[T int32 | uint32 | uint64](f T, flags []struct {
+	flag T
+	name string
+}) string {
+	var fs []string
+	for _, v := range flags {
+		if f&v.flag == v.flag {
+			fs = append(fs, v.name)
+		}
+	}
+
+	if len(fs) == 0 {
+		return fmt.Sprintf("%v", f)
+	}
+
+	return strings.Join(fs, "|")
+}
+
 // Constants representing various clone flags used in system calls.
 const (
 	CSIGNAL              = 0x000000ff // Signal mask to be sent at exit.
@@ -105,6 +261,9 @@ const (
 	CLONE_NEWPID         = 0x20000000 // Create a new PID namespace.
 	CLONE_NEWNET         = 0x40000000 // Create a new network namespace.
 	CLONE_IO             = 0x80000000 // Clone I/O context.
+
+	CLONE_CLEAR_SIGHAND = 0x100000000 // Reset the signal handlers of the child, clone3 only.
+	CLONE_INTO_CGROUP   = 0x200000000 // Create the child in the cgroup of clone_args.cgroup, clone3 only.
 )
 
 // cloneFlags represents various clone flags used in system calls.
@@ -134,6 +293,8 @@ var cloneFlags = map[uint64]string{
 	CLONE_NEWPID:         "CLONE_NEWPID",
 	CLONE_NEWNET:         "CLONE_NEWNET",
 	CLONE_IO:             "CLONE_IO",
+	CLONE_CLEAR_SIGHAND:  "CLONE_CLEAR_SIGHAND",
+	CLONE_INTO_CGROUP:    "CLONE_INTO_CGROUP",
 }
 
 // parseCloneFlags parses the given flag value and returns a string representation
@@ -165,6 +326,129 @@ func parseCloneFlags(flag any) (string, error) {
 	return strings.Join(fs, "|"), nil
 }
 
+// parseNamespaceType takes the namespace type of setns and returns its CLONE_NEW* flag, or 0 if any
+// namespace type is allowed.
+func parseNamespaceType(nstype any) (string, error) {
+	t, ok := nstype.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", nstype), transformErr.Throwf("parseNamespaceType: parse value error expected %T received %T", t, nstype)
+	}
+
+	if t == 0 {
+		return "0", nil
+	}
+
+	return parseCloneFlags(uint64(uint32(t)))
+}
+
+// Constants representing the flags of mount.
+const (
+	MS_RDONLY      = 0x00000001 // Mount read-only.
+	MS_NOSUID      = 0x00000002 // Ignore the set-user-id and set-group-id bits.
+	MS_NODEV       = 0x00000004 // Disallow access to device special files.
+	MS_NOEXEC      = 0x00000008 // Disallow program execution.
+	MS_SYNCHRONOUS = 0x00000010 // Writes are synced at once.
+	MS_REMOUNT     = 0x00000020 // Alter the flags of a mounted file system.
+	MS_MANDLOCK    = 0x00000040 // Allow mandatory locks.
+	MS_DIRSYNC     = 0x00000080 // Directory modifications are synchronous.
+	MS_NOSYMFOLLOW = 0x00000100 // Do not follow symlinks.
+	MS_NOATIME     = 0x00000400 // Do not update access times.
+	MS_NODIRATIME  = 0x00000800 // Do not update directory access times.
+	MS_BIND        = 0x00001000 // Create a bind mount.
+	MS_MOVE        = 0x00002000 // Move a subtree.
+	MS_REC         = 0x00004000 // Apply recursively.
+	MS_SILENT      = 0x00008000 // Suppress some kernel warnings.
+	MS_POSIXACL    = 0x00010000 // Support POSIX access control lists.
+	MS_UNBINDABLE  = 0x00020000 // Change to unbindable.
+	MS_PRIVATE     = 0x00040000 // Change to private.
+	MS_SLAVE       = 0x00080000 // Change to slave.
+	MS_SHARED      = 0x00100000 // Change to shared.
+	MS_RELATIME    = 0x00200000 // Update access times relative to the modify or change times.
+	MS_KERNMOUNT   = 0x00400000 // Mounted by the kernel.
+	MS_I_VERSION   = 0x00800000 // Update the inode version.
+	MS_STRICTATIME = 0x01000000 // Always update access times.
+	MS_LAZYTIME    = 0x02000000 // Update the times lazily.
+
+	MS_MGC_VAL = 0xc0ed0000 // Magic number of the flags of old mount calls.
+	MS_MGC_MSK = 0xffff0000 // Mask of the magic number.
+)
+
+// mountFlag represents the flags of mount.
+var mountFlag = []struct {
+	flag uint64
+	name string
+}{
+	{MS_RDONLY, "MS_RDONLY"},
+	{MS_NOSUID, "MS_NOSUID"},
+	{MS_NODEV, "MS_NODEV"},
+	{MS_NOEXEC, "MS_NOEXEC"},
+	{MS_SYNCHRONOUS, "MS_SYNCHRONOUS"},
+	{MS_REMOUNT, "MS_REMOUNT"},
+	{MS_MANDLOCK, "MS_MANDLOCK"},
+	{MS_DIRSYNC, "MS_DIRSYNC"},
+	{MS_NOSYMFOLLOW, "MS_NOSYMFOLLOW"},
+	{MS_NOATIME, "MS_NOATIME"},
+	{MS_NODIRATIME, "MS_NODIRATIME"},
+	{MS_BIND, "MS_BIND"},
+	{MS_MOVE, "MS_MOVE"},
+	{MS_REC, "MS_REC"},
+	{MS_SILENT, "MS_SILENT"},
+	{MS_POSIXACL, "MS_POSIXACL"},
+	{MS_UNBINDABLE, "MS_UNBINDABLE"},
+	{MS_PRIVATE, "MS_PRIVATE"},
+	{MS_SLAVE, "MS_SLAVE"},
+	{MS_SHARED, "MS_SHARED"},
+	{MS_RELATIME, "MS_RELATIME"},
+	{MS_KERNMOUNT, "MS_KERNMOUNT"},
+	{MS_I_VERSION, "MS_I_VERSION"},
+	{MS_STRICTATIME, "MS_STRICTATIME"},
+	{MS_LAZYTIME, "MS_LAZYTIME"},
+}
+
+// parseMountFlags takes the flags of mount and returns their names. The magic number
+// of old mount calls is dropped, as done by the kernel.
+func parseMountFlags(flag any) (string, error) {
+	f, ok := flag.(uint64)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseMountFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	if f&MS_MGC_MSK == MS_MGC_VAL {
+		f &^= MS_MGC_MSK
+	}
+
+	return joinFlags(f, mountFlag), nil
+}
+
+// Constants representing the flags of umount.
+const (
+	MNT_FORCE       = 0x00000001 // Force the unmount even if busy.
+	MNT_DETACH      = 0x00000002 // Lazy unmount.
+	MNT_EXPIRE      = 0x00000004 // Mark the mount as expired.
+	UMOUNT_NOFOLLOW = 0x00000008 // Do not follow a symlink target.
+)
+
+// umountFlag represents the flags of umount.
+var umountFlag = []struct {
+	flag int32
+	name string
+}{
+	{MNT_FORCE, "MNT_FORCE"},
+	{MNT_DETACH, "MNT_DETACH"},
+	{MNT_EXPIRE, "MNT_EXPIRE"},
+	{UMOUNT_NOFOLLOW, "UMOUNT_NOFOLLOW"},
+}
+
+// parseUmountFlags takes the flags of umount and returns their names.
+func parseUmountFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseUmountFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, umountFlag), nil
+}
+
 // signalMap represents various signal numbers and their corresponding names.
 var signalMap = map[uint16]string{
 	1:  "SIGHUP",    // Hangup (terminal line disconnected).
@@ -210,6 +494,20 @@ func parseSignal(sig uint16) string {
 	return name
 }
 
+// parseExitSignal takes the exit signal of clone3 and returns its name, or 0 if no signal is sent.
+func parseExitSignal(sig any) (string, error) {
+	s, ok := sig.(uint64)
+	if !ok {
+		return fmt.Sprintf("%v", sig), transformErr.Throwf("parseExitSignal: parse value error expected %T received %T", s, sig)
+	}
+
+	if s == 0 {
+		return "0", nil
+	}
+
+	return parseSignal(uint16(s)), nil
+}
+
 // parseOpenMode takes an open mode value (mode) and returns its octal representation.
 func parseOpenMode(mode any) (string, error) {
 	m, ok := mode.(uint32)
@@ -514,3 +812,617 @@ func parseSocketProtocol(protocol any) (string, error) {
 
 	return fmt.Sprintf("%v", p), nil
 }
+
+// tlsLibraries represents the TLS implementations whose plaintext is captured, as reported by the eBPF programs.
+var tlsLibraries = map[uint8]string{
+	0: "openssl", // OpenSSL and BoringSSL, through SSL_write and SSL_read
+	1: "go",      // Go crypto/tls, through (*Conn).Write and (*Conn).Read
+}
+
+// parseTlsLibrary takes the TLS library value of a tls event and returns its name.
+func parseTlsLibrary(library any) (string, error) {
+	l, ok := library.(uint8)
+	if !ok {
+		return fmt.Sprintf("%v", library), transformErr.Throwf("parseTlsLibrary: parse value error expected %T received %T", l, library)
+	}
+
+	if name, ok := tlsLibraries[l]; ok {
+		return name, nil
+	}
+
+	return fmt.Sprintf("%v", l), nil
+}
+
+// verdicts represents the decisions of the enforcement programs on a matched policy rule.
+var verdicts = map[uint8]string{
+	0: "allowed", // the rule audits the action
+	1: "blocked", // the rule denies the action
+}
+
+// parseVerdict takes the verdict value of an enforcement event and returns its name.
+func parseVerdict(verdict any) (string, error) {
+	v, ok := verdict.(uint8)
+	if !ok {
+		return fmt.Sprintf("%v", verdict), transformErr.Throwf("parseVerdict: parse value error expected %T received %T", v, verdict)
+	}
+
+	if name, ok := verdicts[v]; ok {
+		return name, nil
+	}
+
+	return fmt.Sprintf("%v", v), nil
+}
+
+// capabilities represents the linux capabilities, indexed by their bit in a capability set.
+var capabilities = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_DAC_READ_SEARCH",
+	"CAP_FOWNER",
+	"CAP_FSETID",
+	"CAP_KILL",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETPCAP",
+	"CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_NET_BROADCAST",
+	"CAP_NET_ADMIN",
+	"CAP_NET_RAW",
+	"CAP_IPC_LOCK",
+	"CAP_IPC_OWNER",
+	"CAP_SYS_MODULE",
+	"CAP_SYS_RAWIO",
+	"CAP_SYS_CHROOT",
+	"CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT",
+	"CAP_SYS_ADMIN",
+	"CAP_SYS_BOOT",
+	"CAP_SYS_NICE",
+	"CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME",
+	"CAP_SYS_TTY_CONFIG",
+	"CAP_MKNOD",
+	"CAP_LEASE",
+	"CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL",
+	"CAP_SETFCAP",
+	"CAP_MAC_OVERRIDE",
+	"CAP_MAC_ADMIN",
+	"CAP_SYSLOG",
+	"CAP_WAKE_ALARM",
+	"CAP_BLOCK_SUSPEND",
+	"CAP_AUDIT_READ",
+	"CAP_PERFMON",
+	"CAP_BPF",
+	"CAP_CHECKPOINT_RESTORE",
+}
+
+// parseCapabilities takes a capability set and returns the names of its capabilities joined by |.
+// Bits of capabilities unknown to the parser are reported by number.
+func parseCapabilities(caps any) (string, error) {
+	c, ok := caps.(uint64)
+	if !ok {
+		return fmt.Sprintf("%v", caps), transformErr.Throwf("parseCapabilities: parse value error expected %T received %T", c, caps)
+	}
+
+	if c == 0 {
+		return "0", nil
+	}
+
+	var cs []string
+	for bit := 0; bit < 64; bit++ {
+		if c&(1<<bit) == 0 {
+			continue
+		}
+
+		if bit < len(capabilities) {
+			cs = append(cs, capabilities[bit])
+		} else {
+			cs = append(cs, fmt.Sprintf("%d", bit))
+		}
+	}
+
+	return strings.Join(cs, "|"), nil
+}
+
+// Constants representing the flags of finit_module.
+const (
+	MODULE_INIT_IGNORE_MODVERSIONS = 0x1 // Ignore the symbol version hashes.
+	MODULE_INIT_IGNORE_VERMAGIC    = 0x2 // Ignore the kernel version magic.
+	MODULE_INIT_COMPRESSED_FILE    = 0x4 // The module file is compressed.
+)
+
+// finitModuleFlag represents the flags of finit_module.
+var finitModuleFlag = []struct {
+	flag int32
+	name string
+}{
+	{MODULE_INIT_IGNORE_MODVERSIONS, "MODULE_INIT_IGNORE_MODVERSIONS"},
+	{MODULE_INIT_IGNORE_VERMAGIC, "MODULE_INIT_IGNORE_VERMAGIC"},
+	{MODULE_INIT_COMPRESSED_FILE, "MODULE_INIT_COMPRESSED_FILE"},
+}
+
+// parseFinitModuleFlags takes the flags of finit_module and returns their names.
+func parseFinitModuleFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseFinitModuleFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, finitModuleFlag), nil
+}
+
+// deleteModuleFlag represents the flags of delete_module.
+var deleteModuleFlag = []struct {
+	flag uint32
+	name string
+}{
+	{O_NONBLOCK, "O_NONBLOCK"},
+	{O_TRUNC, "O_TRUNC"},
+}
+
+// parseDeleteModuleFlags takes the flags of delete_module and returns their names.
+func parseDeleteModuleFlags(flag any) (string, error) {
+	f, ok := flag.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseDeleteModuleFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, deleteModuleFlag), nil
+}
+
+// bpfCmds represents the commands of the bpf syscall, indexed by their value.
+var bpfCmds = []string{
+	"BPF_MAP_CREATE",
+	"BPF_MAP_LOOKUP_ELEM",
+	"BPF_MAP_UPDATE_ELEM",
+	"BPF_MAP_DELETE_ELEM",
+	"BPF_MAP_GET_NEXT_KEY",
+	"BPF_PROG_LOAD",
+	"BPF_OBJ_PIN",
+	"BPF_OBJ_GET",
+	"BPF_PROG_ATTACH",
+	"BPF_PROG_DETACH",
+	"BPF_PROG_TEST_RUN",
+	"BPF_PROG_GET_NEXT_ID",
+	"BPF_MAP_GET_NEXT_ID",
+	"BPF_PROG_GET_FD_BY_ID",
+	"BPF_MAP_GET_FD_BY_ID",
+	"BPF_OBJ_GET_INFO_BY_FD",
+	"BPF_PROG_QUERY",
+	"BPF_RAW_TRACEPOINT_OPEN",
+	"BPF_BTF_LOAD",
+	"BPF_BTF_GET_FD_BY_ID",
+	"BPF_TASK_FD_QUERY",
+	"BPF_MAP_LOOKUP_AND_DELETE_ELEM",
+	"BPF_MAP_FREEZE",
+	"BPF_BTF_GET_NEXT_ID",
+	"BPF_MAP_LOOKUP_BATCH",
+	"BPF_MAP_LOOKUP_AND_DELETE_BATCH",
+	"BPF_MAP_UPDATE_BATCH",
+	"BPF_MAP_DELETE_BATCH",
+	"BPF_LINK_CREATE",
+	"BPF_LINK_UPDATE",
+	"BPF_LINK_GET_FD_BY_ID",
+	"BPF_LINK_GET_NEXT_ID",
+	"BPF_ENABLE_STATS",
+	"BPF_ITER_CREATE",
+	"BPF_LINK_DETACH",
+	"BPF_PROG_BIND_MAP",
+	"BPF_TOKEN_CREATE",
+}
+
+// bpfProgTypes represents the types of the BPF programs, indexed by their value.
+var bpfProgTypes = []string{
+	"BPF_PROG_TYPE_UNSPEC",
+	"BPF_PROG_TYPE_SOCKET_FILTER",
+	"BPF_PROG_TYPE_KPROBE",
+	"BPF_PROG_TYPE_SCHED_CLS",
+	"BPF_PROG_TYPE_SCHED_ACT",
+	"BPF_PROG_TYPE_TRACEPOINT",
+	"BPF_PROG_TYPE_XDP",
+	"BPF_PROG_TYPE_PERF_EVENT",
+	"BPF_PROG_TYPE_CGROUP_SKB",
+	"BPF_PROG_TYPE_CGROUP_SOCK",
+	"BPF_PROG_TYPE_LWT_IN",
+	"BPF_PROG_TYPE_LWT_OUT",
+	"BPF_PROG_TYPE_LWT_XMIT",
+	"BPF_PROG_TYPE_SOCK_OPS",
+	"BPF_PROG_TYPE_SK_SKB",
+	"BPF_PROG_TYPE_CGROUP_DEVICE",
+	"BPF_PROG_TYPE_SK_MSG",
+	"BPF_PROG_TYPE_RAW_TRACEPOINT",
+	"BPF_PROG_TYPE_CGROUP_SOCK_ADDR",
+	"BPF_PROG_TYPE_LWT_SEG6LOCAL",
+	"BPF_PROG_TYPE_LIRC_MODE2",
+	"BPF_PROG_TYPE_SK_REUSEPORT",
+	"BPF_PROG_TYPE_FLOW_DISSECTOR",
+	"BPF_PROG_TYPE_CGROUP_SYSCTL",
+	"BPF_PROG_TYPE_RAW_TRACEPOINT_WRITABLE",
+	"BPF_PROG_TYPE_CGROUP_SOCKOPT",
+	"BPF_PROG_TYPE_TRACING",
+	"BPF_PROG_TYPE_STRUCT_OPS",
+	"BPF_PROG_TYPE_EXT",
+	"BPF_PROG_TYPE_LSM",
+	"BPF_PROG_TYPE_SK_LOOKUP",
+	"BPF_PROG_TYPE_SYSCALL",
+	"BPF_PROG_TYPE_NETFILTER",
+}
+
+// bpfMapTypes represents the types of the BPF maps, indexed by their value.
+var bpfMapTypes = []string{
+	"BPF_MAP_TYPE_UNSPEC",
+	"BPF_MAP_TYPE_HASH",
+	"BPF_MAP_TYPE_ARRAY",
+	"BPF_MAP_TYPE_PROG_ARRAY",
+	"BPF_MAP_TYPE_PERF_EVENT_ARRAY",
+	"BPF_MAP_TYPE_PERCPU_HASH",
+	"BPF_MAP_TYPE_PERCPU_ARRAY",
+	"BPF_MAP_TYPE_STACK_TRACE",
+	"BPF_MAP_TYPE_CGROUP_ARRAY",
+	"BPF_MAP_TYPE_LRU_HASH",
+	"BPF_MAP_TYPE_LRU_PERCPU_HASH",
+	"BPF_MAP_TYPE_LPM_TRIE",
+	"BPF_MAP_TYPE_ARRAY_OF_MAPS",
+	"BPF_MAP_TYPE_HASH_OF_MAPS",
+	"BPF_MAP_TYPE_DEVMAP",
+	"BPF_MAP_TYPE_SOCKMAP",
+	"BPF_MAP_TYPE_CPUMAP",
+	"BPF_MAP_TYPE_XSKMAP",
+	"BPF_MAP_TYPE_SOCKHASH",
+	"BPF_MAP_TYPE_CGROUP_STORAGE",
+	"BPF_MAP_TYPE_REUSEPORT_SOCKARRAY",
+	"BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE",
+	"BPF_MAP_TYPE_QUEUE",
+	"BPF_MAP_TYPE_STACK",
+	"BPF_MAP_TYPE_SK_STORAGE",
+	"BPF_MAP_TYPE_DEVMAP_HASH",
+	"BPF_MAP_TYPE_STRUCT_OPS",
+	"BPF_MAP_TYPE_RINGBUF",
+	"BPF_MAP_TYPE_INODE_STORAGE",
+	"BPF_MAP_TYPE_TASK_STORAGE",
+	"BPF_MAP_TYPE_BLOOM_FILTER",
+	"BPF_MAP_TYPE_USER_RINGBUF",
+	"BPF_MAP_TYPE_CGRP_STORAGE",
+	"BPF_MAP_TYPE_ARENA",
+}
+
+// parseBpfCmd takes the command of the bpf syscall and returns its name.
+func parseBpfCmd(cmd any) (string, error) {
+	c, ok := cmd.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", cmd), transformErr.Throwf("parseBpfCmd: parse value error expected %T received %T", c, cmd)
+	}
+
+	if c >= 0 && int(c) < len(bpfCmds) {
+		return bpfCmds[c], nil
+	}
+
+	return fmt.Sprintf("%v", c), nil
+}
+
+// parseBpfProgType takes the type of a BPF program loaded by the bpf syscall and returns its name.
+func parseBpfProgType(t any) (string, error) {
+	pt, ok := t.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", t), transformErr.Throwf("parseBpfProgType: parse value error expected %T received %T", pt, t)
+	}
+
+	if int(pt) < len(bpfProgTypes) {
+		return bpfProgTypes[pt], nil
+	}
+
+	return fmt.Sprintf("%v", pt), nil
+}
+
+// parseBpfMapType takes the type of a BPF map created by the bpf syscall and returns its name.
+func parseBpfMapType(t any) (string, error) {
+	mt, ok := t.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", t), transformErr.Throwf("parseBpfMapType: parse value error expected %T received %T", mt, t)
+	}
+
+	if int(mt) < len(bpfMapTypes) {
+		return bpfMapTypes[mt], nil
+	}
+
+	return fmt.Sprintf("%v", mt), nil
+}
+
+// ptraceRequests represents the requests of ptrace shared by amd64 and arm64.
+var ptraceRequests = map[int64]string{
+	0:      "PTRACE_TRACEME",
+	1:      "PTRACE_PEEKTEXT",
+	2:      "PTRACE_PEEKDATA",
+	3:      "PTRACE_PEEKUSR",
+	4:      "PTRACE_POKETEXT",
+	5:      "PTRACE_POKEDATA",
+	6:      "PTRACE_POKEUSR",
+	7:      "PTRACE_CONT",
+	8:      "PTRACE_KILL",
+	9:      "PTRACE_SINGLESTEP",
+	16:     "PTRACE_ATTACH",
+	17:     "PTRACE_DETACH",
+	24:     "PTRACE_SYSCALL",
+	0x4200: "PTRACE_SETOPTIONS",
+	0x4201: "PTRACE_GETEVENTMSG",
+	0x4202: "PTRACE_GETSIGINFO",
+	0x4203: "PTRACE_SETSIGINFO",
+	0x4204: "PTRACE_GETREGSET",
+	0x4205: "PTRACE_SETREGSET",
+	0x4206: "PTRACE_SEIZE",
+	0x4207: "PTRACE_INTERRUPT",
+	0x4208: "PTRACE_LISTEN",
+	0x4209: "PTRACE_PEEKSIGINFO",
+	0x420a: "PTRACE_GETSIGMASK",
+	0x420b: "PTRACE_SETSIGMASK",
+	0x420c: "PTRACE_SECCOMP_GET_FILTER",
+	0x420d: "PTRACE_SECCOMP_GET_METADATA",
+	0x420e: "PTRACE_GET_SYSCALL_INFO",
+	0x420f: "PTRACE_GET_RSEQ_CONFIGURATION",
+}
+
+// archPtraceRequests represents the requests of ptrace defined only on some architectures, keyed by GOARCH.
+var archPtraceRequests = map[string]map[int64]string{
+	"amd64": {
+		12: "PTRACE_GETREGS",
+		13: "PTRACE_SETREGS",
+		14: "PTRACE_GETFPREGS",
+		15: "PTRACE_SETFPREGS",
+	},
+}
+
+// parsePtraceRequest takes the request of ptrace and returns its name.
+func parsePtraceRequest(request any) (string, error) {
+	r, ok := request.(int64)
+	if !ok {
+		return fmt.Sprintf("%v", request), transformErr.Throwf("parsePtraceRequest: parse value error expected %T received %T", r, request)
+	}
+
+	if name, ok := ptraceRequests[r]; ok {
+		return name, nil
+	}
+
+	if name, ok := archPtraceRequests[Arch][r]; ok {
+		return name, nil
+	}
+
+	return fmt.Sprintf("%v", r), nil
+}
+
+// Constants representing the flags of memfd_create.
+const (
+	MFD_CLOEXEC       = 0x0001 // Close the file descriptor on exec.
+	MFD_ALLOW_SEALING = 0x0002 // Allow the sealing operations.
+	MFD_HUGETLB       = 0x0004 // Back the file with huge pages.
+	MFD_NOEXEC_SEAL   = 0x0008 // Seal the file as not executable.
+	MFD_EXEC          = 0x0010 // Allow the file to be executed.
+)
+
+// memfdFlag represents the flags of memfd_create.
+var memfdFlag = []struct {
+	flag uint32
+	name string
+}{
+	{MFD_CLOEXEC, "MFD_CLOEXEC"},
+	{MFD_ALLOW_SEALING, "MFD_ALLOW_SEALING"},
+	{MFD_HUGETLB, "MFD_HUGETLB"},
+	{MFD_NOEXEC_SEAL, "MFD_NOEXEC_SEAL"},
+	{MFD_EXEC, "MFD_EXEC"},
+}
+
+// parseMemfdFlags takes the flags of memfd_create and returns their names.
+func parseMemfdFlags(flag any) (string, error) {
+	f, ok := flag.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseMemfdFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, memfdFlag), nil
+}
+
+// Constants representing the memory protections of mmap and mprotect.
+const (
+	PROT_NONE      = 0x00000000 // Pages may not be accessed.
+	PROT_READ      = 0x00000001 // Pages may be read.
+	PROT_WRITE     = 0x00000002 // Pages may be written.
+	PROT_EXEC      = 0x00000004 // Pages may be executed.
+	PROT_SEM       = 0x00000008 // Pages may be used for atomic operations.
+	PROT_GROWSDOWN = 0x01000000 // Extend the change to the start of a grows down mapping.
+	PROT_GROWSUP   = 0x02000000 // Extend the change to the end of a grows up mapping.
+)
+
+// protFlag represents the memory protections of mmap and mprotect.
+var protFlag = []struct {
+	flag int32
+	name string
+}{
+	{PROT_READ, "PROT_READ"},
+	{PROT_WRITE, "PROT_WRITE"},
+	{PROT_EXEC, "PROT_EXEC"},
+	{PROT_SEM, "PROT_SEM"},
+	{PROT_GROWSDOWN, "PROT_GROWSDOWN"},
+	{PROT_GROWSUP, "PROT_GROWSUP"},
+}
+
+// parseProtFlags takes the memory protection of mmap or mprotect and returns its names.
+func parseProtFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseProtFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	if f == PROT_NONE {
+		return "PROT_NONE", nil
+	}
+
+	return joinFlags(f, protFlag), nil
+}
+
+// Constants representing the flags of mmap.
+const (
+	MAP_SHARED          = 0x00000001 // Share the mapping.
+	MAP_PRIVATE         = 0x00000002 // Create a private copy on write mapping.
+	MAP_SHARED_VALIDATE = 0x00000003 // Share the mapping and validate the flags.
+	MAP_TYPE            = 0x0000000f // Mask of the mapping type.
+	MAP_FIXED           = 0x00000010 // Place the mapping at exactly addr.
+	MAP_ANONYMOUS       = 0x00000020 // The mapping is not backed by a file.
+	MAP_GROWSDOWN       = 0x00000100 // The mapping grows down, for stacks.
+	MAP_DENYWRITE       = 0x00000800 // Ignored.
+	MAP_EXECUTABLE      = 0x00001000 // Ignored.
+	MAP_LOCKED          = 0x00002000 // Lock the pages of the mapping.
+	MAP_NORESERVE       = 0x00004000 // Do not reserve swap space.
+	MAP_POPULATE        = 0x00008000 // Prefault the page tables.
+	MAP_NONBLOCK        = 0x00010000 // Do not block on IO, with MAP_POPULATE.
+	MAP_STACK           = 0x00020000 // The mapping is a stack.
+	MAP_HUGETLB         = 0x00040000 // Create a huge page mapping.
+	MAP_SYNC            = 0x00080000 // Perform synchronous page faults.
+	MAP_FIXED_NOREPLACE = 0x00100000 // Place the mapping at exactly addr if it is free.
+	MAP_UNINITIALIZED   = 0x04000000 // Do not clear the anonymous pages.
+)
+
+// mapTypes represents the types of mapping of mmap.
+var mapTypes = map[int32]string{
+	MAP_SHARED:          "MAP_SHARED",
+	MAP_PRIVATE:         "MAP_PRIVATE",
+	MAP_SHARED_VALIDATE: "MAP_SHARED_VALIDATE",
+}
+
+// mapFlag represents the flags of mmap, other than the type of mapping.
+var mapFlag = []struct {
+	flag int32
+	name string
+}{
+	{MAP_FIXED, "MAP_FIXED"},
+	{MAP_ANONYMOUS, "MAP_ANONYMOUS"},
+	{MAP_GROWSDOWN, "MAP_GROWSDOWN"},
+	{MAP_DENYWRITE, "MAP_DENYWRITE"},
+	{MAP_EXECUTABLE, "MAP_EXECUTABLE"},
+	{MAP_LOCKED, "MAP_LOCKED"},
+	{MAP_NORESERVE, "MAP_NORESERVE"},
+	{MAP_POPULATE, "MAP_POPULATE"},
+	{MAP_NONBLOCK, "MAP_NONBLOCK"},
+	{MAP_STACK, "MAP_STACK"},
+	{MAP_HUGETLB, "MAP_HUGETLB"},
+	{MAP_SYNC, "MAP_SYNC"},
+	{MAP_FIXED_NOREPLACE, "MAP_FIXED_NOREPLACE"},
+	{MAP_UNINITIALIZED, "MAP_UNINITIALIZED"},
+}
+
+// parseMapFlags takes the flags of mmap and returns the type of mapping followed by the names of the flags.
+func parseMapFlags(flag any) (string, error) {
+	f, ok := flag.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseMapFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	var fs []string
+	if name, ok := mapTypes[f&MAP_TYPE]; ok {
+		fs = append(fs, name)
+	}
+
+	if rest := f &^ MAP_TYPE; rest != 0 {
+		fs = append(fs, joinFlags(rest, mapFlag))
+	}
+
+	if len(fs) == 0 {
+		return fmt.Sprintf("%v", f), nil
+	}
+
+	return strings.Join(fs, "|"), nil
+}
+
+// Constants representing the flags of the socket message syscalls.
+const (
+	MSG_OOB          = 0x00000001 // Process out-of-band data.
+	MSG_PEEK         = 0x00000002 // Peek at the incoming data without removing it.
+	MSG_DONTROUTE    = 0x00000004 // Do not use a gateway to send the message.
+	MSG_CTRUNC       = 0x00000008 // Control data was truncated.
+	MSG_PROXY        = 0x00000010 // Supply or ask for the second address.
+	MSG_TRUNC        = 0x00000020 // Return the real length of a truncated datagram.
+	MSG_DONTWAIT     = 0x00000040 // Do not block.
+	MSG_EOR          = 0x00000080 // Terminate a record.
+	MSG_WAITALL      = 0x00000100 // Wait for the full request.
+	MSG_FIN          = 0x00000200 // Send a FIN.
+	MSG_SYN          = 0x00000400 // Send a SYN.
+	MSG_CONFIRM      = 0x00000800 // Confirm the validity of the path.
+	MSG_RST          = 0x00001000 // Send a RST.
+	MSG_ERRQUEUE     = 0x00002000 // Receive the queued errors.
+	MSG_NOSIGNAL     = 0x00004000 // Do not raise SIGPIPE.
+	MSG_MORE         = 0x00008000 // More data will follow.
+	MSG_WAITFORONE   = 0x00010000 // Wait for at least one message, with recvmmsg.
+	MSG_BATCH        = 0x00040000 // More messages will follow, with sendmmsg.
+	MSG_ZEROCOPY     = 0x04000000 // Send the data without copying it.
+	MSG_FASTOPEN     = 0x20000000 // Send the data in the TCP SYN.
+	MSG_CMSG_CLOEXEC = 0x40000000 // Set close on exec on the received file descriptors.
+)
+
+// msgFlag represents the flags of the socket message syscalls.
+var msgFlag = []struct {
+	flag uint32
+	name string
+}{
+	{MSG_OOB, "MSG_OOB"},
+	{MSG_PEEK, "MSG_PEEK"},
+	{MSG_DONTROUTE, "MSG_DONTROUTE"},
+	{MSG_CTRUNC, "MSG_CTRUNC"},
+	{MSG_PROXY, "MSG_PROXY"},
+	{MSG_TRUNC, "MSG_TRUNC"},
+	{MSG_DONTWAIT, "MSG_DONTWAIT"},
+	{MSG_EOR, "MSG_EOR"},
+	{MSG_WAITALL, "MSG_WAITALL"},
+	{MSG_FIN, "MSG_FIN"},
+	{MSG_SYN, "MSG_SYN"},
+	{MSG_CONFIRM, "MSG_CONFIRM"},
+	{MSG_RST, "MSG_RST"},
+	{MSG_ERRQUEUE, "MSG_ERRQUEUE"},
+	{MSG_NOSIGNAL, "MSG_NOSIGNAL"},
+	{MSG_MORE, "MSG_MORE"},
+	{MSG_WAITFORONE, "MSG_WAITFORONE"},
+	{MSG_BATCH, "MSG_BATCH"},
+	{MSG_ZEROCOPY, "MSG_ZEROCOPY"},
+	{MSG_FASTOPEN, "MSG_FASTOPEN"},
+	{MSG_CMSG_CLOEXEC, "MSG_CMSG_CLOEXEC"},
+}
+
+// parseMsgFlags takes the flags of sendto, recvfrom, sendmsg and recvmsg and returns their names.
+func parseMsgFlags(flag any) (string, error) {
+	f, ok := flag.(uint32)
+	if !ok {
+		return fmt.Sprintf("%v", flag), transformErr.Throwf("parseMsgFlags: parse value error expected %T received %T", f, flag)
+	}
+
+	return joinFlags(f, msgFlag), nil
+}
+
+// tcpStates holds the names of the states of the tcp sockets, indexed by state.
+var tcpStates = []string{
+	"",
+	"TCP_ESTABLISHED",
+	"TCP_SYN_SENT",
+	"TCP_SYN_RECV",
+	"TCP_FIN_WAIT1",
+	"TCP_FIN_WAIT2",
+	"TCP_TIME_WAIT",
+	"TCP_CLOSE",
+	"TCP_CLOSE_WAIT",
+	"TCP_LAST_ACK",
+	"TCP_LISTEN",
+	"TCP_CLOSING",
+	"TCP_NEW_SYN_RECV",
+}
+
+// parseTcpState takes the state of a tcp socket and returns its name.
+func parseTcpState(state any) (string, error) {
+	s, ok := state.(int32)
+	if !ok {
+		return fmt.Sprintf("%v", state), transformErr.Throwf("parseTcpState: parse value error expected %T received %T", s, state)
+	}
+
+	if s > 0 && int(s) < len(tcpStates) {
+		return tcpStates[s], nil
+	}
+
+	return fmt.Sprintf("%v", s), nil
+}
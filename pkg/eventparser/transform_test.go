@@ -543,3 +543,398 @@ func Test_parseSocketProtocol(t *testing.T) {
 		})
 	}
 }
+
+// Test_parseTlsLibrary tests the parseTlsLibrary function
+func Test_parseTlsLibrary(t *testing.T) {
+	tests := []struct {
+		name    string
+		library any
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "invalid value type",
+			library: 1,
+			want:    "1",
+			wantErr: true,
+		},
+		{
+			name:    "openssl",
+			library: uint8(0),
+			want:    "openssl",
+		},
+		{
+			name:    "go",
+			library: uint8(1),
+			want:    "go",
+		},
+		{
+			name:    "unknown library",
+			library: uint8(7),
+			want:    "7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTlsLibrary(tt.library)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseTlsLibrary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseTlsLibrary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseVerdict tests the parseVerdict function
+func Test_parseVerdict(t *testing.T) {
+	tests := []struct {
+		name    string
+		verdict any
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "invalid value type",
+			verdict: int32(1),
+			want:    "1",
+			wantErr: true,
+		},
+		{
+			name:    "allowed",
+			verdict: uint8(0),
+			want:    "allowed",
+		},
+		{
+			name:    "blocked",
+			verdict: uint8(1),
+			want:    "blocked",
+		},
+		{
+			name:    "unknown verdict",
+			verdict: uint8(9),
+			want:    "9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVerdict(tt.verdict)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseVerdict() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseVerdict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseFileMutation tests the decoders of the file mutation syscalls
+func Test_parseFileMutation(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (string, error)
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "unlinkat invalid value type", parse: parseUnlinkatFlags, value: 512, want: "512", wantErr: true},
+		{name: "unlinkat no flag", parse: parseUnlinkatFlags, value: int32(0), want: "0"},
+		{name: "unlinkat remove directory", parse: parseUnlinkatFlags, value: int32(AT_REMOVEDIR), want: "AT_REMOVEDIR"},
+		{name: "fchownat invalid value type", parse: parseFchownatFlags, value: uint32(0), want: "0", wantErr: true},
+		{name: "fchownat flags", parse: parseFchownatFlags, value: int32(AT_SYMLINK_NOFOLLOW | AT_EMPTY_PATH), want: "AT_SYMLINK_NOFOLLOW|AT_EMPTY_PATH"},
+		{name: "linkat invalid value type", parse: parseLinkatFlags, value: uint32(0), want: "0", wantErr: true},
+		{name: "linkat flags", parse: parseLinkatFlags, value: int32(AT_SYMLINK_FOLLOW | AT_EMPTY_PATH), want: "AT_SYMLINK_FOLLOW|AT_EMPTY_PATH"},
+		{name: "renameat2 invalid value type", parse: parseRenameFlags, value: int32(1), want: "1", wantErr: true},
+		{name: "renameat2 no flag", parse: parseRenameFlags, value: uint32(0), want: "0"},
+		{name: "renameat2 exchange", parse: parseRenameFlags, value: uint32(RENAME_EXCHANGE), want: "RENAME_EXCHANGE"},
+		{name: "mode invalid value type", parse: parseFileMode, value: int32(0755), want: "493", wantErr: true},
+		{name: "mode permissions", parse: parseFileMode, value: uint32(0644), want: "0644"},
+		{name: "mode setuid", parse: parseFileMode, value: uint32(04755), want: "S_ISUID|0755"},
+		{name: "mode sticky directory", parse: parseFileMode, value: uint32(01777), want: "S_ISVTX|0777"},
+		{name: "chown invalid value type", parse: parseChownId, value: int32(0), want: "0", wantErr: true},
+		{name: "chown root", parse: parseChownId, value: uint32(0), want: "0"},
+		{name: "chown unchanged", parse: parseChownId, value: ^uint32(0), want: "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseCapabilities tests the parseCapabilities function
+func Test_parseCapabilities(t *testing.T) {
+	type args struct {
+		caps any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{name: "invalid value type", args: args{caps: uint32(1)}, want: "1", wantErr: true},
+		{name: "empty set", args: args{caps: uint64(0)}, want: "0"},
+		{name: "single capability", args: args{caps: uint64(1 << 21)}, want: "CAP_SYS_ADMIN"},
+		{name: "several capabilities", args: args{caps: uint64(1<<0 | 1<<7 | 1<<39)}, want: "CAP_CHOWN|CAP_SETUID|CAP_BPF"},
+		{name: "unknown capability", args: args{caps: uint64(1<<12 | 1<<63)}, want: "CAP_NET_ADMIN|63"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCapabilities(tt.args.caps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCapabilities() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseCapabilities() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseNamespaceFlags tests the decoders of the namespace and mount syscalls
+func Test_parseNamespaceFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (string, error)
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "setns invalid value type", parse: parseNamespaceType, value: uint64(0), want: "0", wantErr: true},
+		{name: "setns any namespace", parse: parseNamespaceType, value: int32(0), want: "0"},
+		{name: "setns network namespace", parse: parseNamespaceType, value: int32(CLONE_NEWNET), want: "CLONE_NEWNET"},
+		{name: "mount invalid value type", parse: parseMountFlags, value: int32(0), want: "0", wantErr: true},
+		{name: "mount no flag", parse: parseMountFlags, value: uint64(0), want: "0"},
+		{name: "mount recursive bind", parse: parseMountFlags, value: uint64(MS_BIND | MS_REC), want: "MS_BIND|MS_REC"},
+		{name: "mount magic number", parse: parseMountFlags, value: uint64(MS_MGC_VAL | MS_RDONLY | MS_REMOUNT), want: "MS_RDONLY|MS_REMOUNT"},
+		{name: "umount invalid value type", parse: parseUmountFlags, value: uint32(0), want: "0", wantErr: true},
+		{name: "umount lazy", parse: parseUmountFlags, value: int32(MNT_DETACH), want: "MNT_DETACH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseModuleAndBpf tests the decoders of the kernel module and bpf syscalls
+func Test_parseModuleAndBpf(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (string, error)
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "finit_module invalid value type", parse: parseFinitModuleFlags, value: uint32(0), want: "0", wantErr: true},
+		{name: "finit_module flags", parse: parseFinitModuleFlags, value: int32(MODULE_INIT_IGNORE_MODVERSIONS | MODULE_INIT_IGNORE_VERMAGIC), want: "MODULE_INIT_IGNORE_MODVERSIONS|MODULE_INIT_IGNORE_VERMAGIC"},
+		{name: "delete_module invalid value type", parse: parseDeleteModuleFlags, value: int32(0), want: "0", wantErr: true},
+		{name: "delete_module force", parse: parseDeleteModuleFlags, value: uint32(O_NONBLOCK | O_TRUNC), want: "O_NONBLOCK|O_TRUNC"},
+		{name: "bpf cmd invalid value type", parse: parseBpfCmd, value: uint32(5), want: "5", wantErr: true},
+		{name: "bpf cmd prog load", parse: parseBpfCmd, value: int32(5), want: "BPF_PROG_LOAD"},
+		{name: "bpf cmd map create", parse: parseBpfCmd, value: int32(0), want: "BPF_MAP_CREATE"},
+		{name: "bpf cmd undefined", parse: parseBpfCmd, value: int32(-1), want: "-1"},
+		{name: "prog type invalid value type", parse: parseBpfProgType, value: int32(2), want: "2", wantErr: true},
+		{name: "prog type kprobe", parse: parseBpfProgType, value: uint32(2), want: "BPF_PROG_TYPE_KPROBE"},
+		{name: "prog type lsm", parse: parseBpfProgType, value: uint32(29), want: "BPF_PROG_TYPE_LSM"},
+		{name: "prog type undefined", parse: parseBpfProgType, value: uint32(1000), want: "1000"},
+		{name: "map type invalid value type", parse: parseBpfMapType, value: int32(27), want: "27", wantErr: true},
+		{name: "map type ring buffer", parse: parseBpfMapType, value: uint32(27), want: "BPF_MAP_TYPE_RINGBUF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parsePtraceRequest tests the parsePtraceRequest function
+func Test_parsePtraceRequest(t *testing.T) {
+	type args struct {
+		request any
+		arch    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{name: "invalid value type", args: args{request: int32(16), arch: "amd64"}, want: "16", wantErr: true},
+		{name: "attach", args: args{request: int64(16), arch: "amd64"}, want: "PTRACE_ATTACH"},
+		{name: "seize", args: args{request: int64(0x4206), arch: "arm64"}, want: "PTRACE_SEIZE"},
+		{name: "getregs on amd64", args: args{request: int64(12), arch: "amd64"}, want: "PTRACE_GETREGS"},
+		{name: "getregs on arm64", args: args{request: int64(12), arch: "arm64"}, want: "12"},
+		{name: "valid undefined value", args: args{request: int64(-1), arch: "amd64"}, want: "-1"},
+	}
+
+	defer func(arch string) { Arch = arch }(Arch)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Arch = tt.args.arch
+			got, err := parsePtraceRequest(tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePtraceRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parsePtraceRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseMemoryFlags tests the decoders of memfd_create, mmap and mprotect
+func Test_parseMemoryFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (string, error)
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "memfd invalid value type", parse: parseMemfdFlags, value: int32(1), want: "1", wantErr: true},
+		{name: "memfd no flag", parse: parseMemfdFlags, value: uint32(0), want: "0"},
+		{name: "memfd flags", parse: parseMemfdFlags, value: uint32(MFD_CLOEXEC | MFD_EXEC), want: "MFD_CLOEXEC|MFD_EXEC"},
+		{name: "prot invalid value type", parse: parseProtFlags, value: uint32(4), want: "4", wantErr: true},
+		{name: "prot none", parse: parseProtFlags, value: int32(PROT_NONE), want: "PROT_NONE"},
+		{name: "prot writable executable", parse: parseProtFlags, value: int32(PROT_READ | PROT_WRITE | PROT_EXEC), want: "PROT_READ|PROT_WRITE|PROT_EXEC"},
+		{name: "map invalid value type", parse: parseMapFlags, value: uint64(2), want: "2", wantErr: true},
+		{name: "map private anonymous", parse: parseMapFlags, value: int32(MAP_PRIVATE | MAP_ANONYMOUS), want: "MAP_PRIVATE|MAP_ANONYMOUS"},
+		{name: "map shared validate", parse: parseMapFlags, value: int32(MAP_SHARED_VALIDATE | MAP_SYNC), want: "MAP_SHARED_VALIDATE|MAP_SYNC"},
+		{name: "map no flag", parse: parseMapFlags, value: int32(0), want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseClone3 tests the decoders of clone3
+func Test_parseClone3(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(any) (string, error)
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "exit signal invalid value type", parse: parseExitSignal, value: uint16(17), want: "17", wantErr: true},
+		{name: "no exit signal", parse: parseExitSignal, value: uint64(0), want: "0"},
+		{name: "exit signal", parse: parseExitSignal, value: uint64(17), want: "SIGCHLD"},
+		{name: "clone into cgroup", parse: parseCloneFlags, value: uint64(CLONE_INTO_CGROUP), want: "CLONE_INTO_CGROUP"},
+		{name: "clear signal handlers", parse: parseCloneFlags, value: uint64(CLONE_CLEAR_SIGHAND), want: "CLONE_CLEAR_SIGHAND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseMsgFlags tests the parseMsgFlags function
+func Test_parseMsgFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "invalid value type", value: int32(64), want: "64", wantErr: true},
+		{name: "no flag", value: uint32(0), want: "0"},
+		{name: "flags", value: uint32(MSG_DONTWAIT | MSG_NOSIGNAL), want: "MSG_DONTWAIT|MSG_NOSIGNAL"},
+		{name: "fast open", value: uint32(MSG_FASTOPEN), want: "MSG_FASTOPEN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMsgFlags(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseMsgFlags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseMsgFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseTcpState tests the parseTcpState function
+func Test_parseTcpState(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "invalid value type", value: uint32(1), want: "1", wantErr: true},
+		{name: "established", value: int32(1), want: "TCP_ESTABLISHED"},
+		{name: "new syn recv", value: int32(12), want: "TCP_NEW_SYN_RECV"},
+		{name: "unknown state", value: int32(13), want: "13"},
+		{name: "no state", value: int32(0), want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTcpState(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseTcpState() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseTcpState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/intelops/tarian-detector/pkg/err"
+	"golang.org/x/sys/unix"
 )
 
 var utilsErr = err.New("utils.utils")
@@ -27,16 +30,38 @@ func KernelVersion(major, minor, patch int) int {
 	return (major << 16) + (minor << 8) + patch
 }
 
-// CurrentKernelVersion retrieves the current kernel version from environment variables.
-// It returns an error if the required environment variables are not set or if they cannot be converted to integers.
+// osReleasePath is the file exposing the release of the running kernel, read when uname fails.
+const osReleasePath = "/proc/sys/kernel/osrelease"
+
+// kernelRelease matches the version at the start of a kernel release, e.g. 5.15.0 in 5.15.0-1034-aws.
+var kernelRelease = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// CurrentKernelVersion returns the version of the running kernel, as combined by KernelVersion.
+// The LINUX_VERSION_MAJOR, LINUX_VERSION_MINOR and LINUX_VERSION_PATCH environment variables
+// override the detection when set; they must then all be set to integers.
+// Otherwise the version is parsed from the kernel release reported by uname, or read from
+// /proc/sys/kernel/osrelease if uname fails.
 func CurrentKernelVersion() (int, error) {
+	major, minor, patch := os.Getenv("LINUX_VERSION_MAJOR"), os.Getenv("LINUX_VERSION_MINOR"), os.Getenv("LINUX_VERSION_PATCH")
+	if len(major) != 0 || len(minor) != 0 || len(patch) != 0 {
+		return envKernelVersion(major, minor, patch)
+	}
+
+	release, err := KernelRelease()
+	if err != nil {
+		return 0, err
+	}
+
+	return ParseKernelRelease(release)
+}
+
+// envKernelVersion combines the kernel version given through the environment variables.
+func envKernelVersion(major, minor, patch string) (int, error) {
 	const (
 		envNotFound string = "unable to check the kernel version, LINUX_VERSION_MAJOR, LINUX_VERSION_MINOR, LINUX_VERSION_PATCH must be defined"
 	)
 
-	// Get environment variables
-	major, minor, patch := os.Getenv("LINUX_VERSION_MAJOR"), os.Getenv("LINUX_VERSION_MINOR"), os.Getenv("LINUX_VERSION_PATCH")
-	// Check if environment variables are not set
+	// Check if some of the environment variables are not set
 	if len(major) == 0 || len(minor) == 0 || len(patch) == 0 {
 		return 0, utilsErr.Throw(envNotFound)
 	}
@@ -59,6 +84,48 @@ func CurrentKernelVersion() (int, error) {
 	return KernelVersion(a, b, c), nil
 }
 
+// KernelRelease returns the release of the running kernel, e.g. 5.15.0-1034-aws.
+// It is taken from uname, or read from /proc/sys/kernel/osrelease if uname fails.
+func KernelRelease() (string, error) {
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err == nil {
+		return unix.ByteSliceToString(uts.Release[:]), nil
+	}
+
+	data, err := os.ReadFile(osReleasePath)
+	if err != nil {
+		return "", utilsErr.Throwf("unable to check the kernel release: %v", err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// ParseKernelRelease parses the version at the start of a kernel release and combines it with KernelVersion.
+// Distribution suffixes are ignored, e.g. 5.15.0-1034-aws gives 5.15.0 and 6.1.0+ gives 6.1.0.
+// A missing patch number is read as 0.
+func ParseKernelRelease(release string) (int, error) {
+	m := kernelRelease.FindStringSubmatch(strings.TrimSpace(release))
+	if m == nil {
+		return 0, utilsErr.Throwf("unable to parse the kernel release %q", release)
+	}
+
+	var v [3]int
+	for i, n := range m[1:] {
+		if len(n) == 0 {
+			continue
+		}
+
+		num, err := strconv.Atoi(n)
+		if err != nil {
+			return 0, utilsErr.Throwf("%v", err)
+		}
+
+		v[i] = num
+	}
+
+	return KernelVersion(v[0], v[1], v[2]), nil
+}
+
 // PrintEvent prints the given data map along with a total captured count and a divider.
 // It uses a predefined set of keys to extract values from the data map.
 func PrintEvent(data map[string]any, t int) {
@@ -79,3 +146,14 @@ func PrintEvent(data map[string]any, t int) {
 
 	log.Printf("Total captured %d.\n%s\n%s%s\n", t, div, msg, div)
 }
+
+// MemlockLimit returns the soft and hard limits of the memory the process may lock, in bytes.
+// Kernels before 5.11 charge eBPF maps and programs against the soft limit.
+func MemlockLimit() (uint64, uint64, error) {
+	var rlim unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_MEMLOCK, &rlim); err != nil {
+		return 0, 0, utilsErr.Throwf("%v", err)
+	}
+
+	return rlim.Cur, rlim.Max, nil
+}
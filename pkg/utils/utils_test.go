@@ -125,15 +125,33 @@ func TestCurrentKernelVersion(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "empty values",
+			name: "partial values",
 			args: &args{
-				major: "",
+				major: 5,
 				minor: "",
 				patch: "",
 			},
 			want:    0,
 			wantErr: true,
 		},
+		{
+			name: "empty values detect the running kernel",
+			args: &args{
+				major: "",
+				minor: "",
+				patch: "",
+			},
+			want: func() int {
+				release, err := KernelRelease()
+				if err != nil {
+					return 0
+				}
+
+				v, _ := ParseKernelRelease(release)
+				return v
+			}(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -153,6 +171,51 @@ func TestCurrentKernelVersion(t *testing.T) {
 	}
 }
 
+// TestParseKernelRelease is a Go function for testing the ParseKernelRelease function.
+func TestParseKernelRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		release string
+		want    int
+		wantErr bool
+	}{
+		{name: "plain release", release: "5.8.3", want: KernelVersion(5, 8, 3)},
+		{name: "distro suffix", release: "5.15.0-1034-aws", want: KernelVersion(5, 15, 0)},
+		{name: "plus suffix", release: "6.1.0+", want: KernelVersion(6, 1, 0)},
+		{name: "trailing newline", release: "6.5.0-rc3\n", want: KernelVersion(6, 5, 0)},
+		{name: "missing patch", release: "4.19-generic", want: KernelVersion(4, 19, 0)},
+		{name: "large patch", release: "4.9.337-1", want: KernelVersion(4, 9, 255)},
+		{name: "empty release", release: "", wantErr: true},
+		{name: "invalid release", release: "linux-5.15", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseKernelRelease(tt.release)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseKernelRelease() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseKernelRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestKernelRelease is a Go function for testing the KernelRelease function.
+func TestKernelRelease(t *testing.T) {
+	got, err := KernelRelease()
+	if err != nil {
+		t.Fatalf("KernelRelease() error = %v", err)
+	}
+
+	if _, err := ParseKernelRelease(got); err != nil {
+		t.Errorf("KernelRelease() = %q, not a kernel release: %v", got, err)
+	}
+}
+
 // TestPrintEvent is a Go function for testing the PrintEvent function.
 func TestPrintEvent(t *testing.T) {
 	type args struct {
@@ -5,6 +5,7 @@
 #include "bpf_tracing.h"
 #include "bpf_helpers.h"
 #include "bpf_core_read.h"
+#include "bpf_endian.h"
 
 #include "utils/index.h"
 
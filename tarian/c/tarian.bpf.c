@@ -5,8 +5,33 @@
 
 #include "common.h"
 
-KPROBE("__x64_sys_execve")
-int BPF_KPROBE(tdf_execve_e, struct pt_regs *regs) {
+#define MEMFD_PREFIX "memfd:"
+#define MEMFD_PREFIX_LEN (sizeof(MEMFD_PREFIX) - 1)
+
+// save_memfd_exe saves the name of the memfd executed by a successful execve, e.g. memfd:payload,
+// which is opaque in the filename, /proc/self/fd/N. An empty string is saved for any other file.
+stain void save_memfd_exe(tarian_event_t *te, int ret) {
+  unsigned long name = 0;
+
+  if (ret == 0) {
+    const unsigned char *d_name = BPF_CORE_READ(te->task, mm, exe_file, f_path.dentry, d_name.name);
+
+    char prefix[MEMFD_PREFIX_LEN] = {0};
+    bpf_probe_read_kernel(prefix, sizeof(prefix), d_name);
+
+    name = (unsigned long)d_name;
+    for (int i = 0; i < MEMFD_PREFIX_LEN; i++) {
+      if (prefix[i] != MEMFD_PREFIX[i]) {
+        name = 0;
+        break;
+      }
+    }
+  }
+
+  tdf_flex_save(te, TDT_STR, name, 0, KERNEL);
+}
+
+SYSCALL_ENTRY(execve) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_EXECVE_E, &te, VARIABLE, TDS_EXECVE_E);
   if (resp != TDC_SUCCESS) {
@@ -23,10 +48,9 @@ int BPF_KPROBE(tdf_execve_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_execve")
-int BPF_KRETPROBE(tdf_execve_r, int ret) {
+SYSCALL_EXIT(execve, int) {
   tarian_event_t te;
-  int resp = new_event(ctx, TDE_SYSCALL_EXECVE_R, &te, FIXED, TDS_EXECVE_R);
+  int resp = new_event(ctx, TDE_SYSCALL_EXECVE_R, &te, VARIABLE, TDS_EXECVE_R);
   if (resp != TDC_SUCCESS) {
     stats__add(resp);
     return resp;
@@ -34,13 +58,13 @@ int BPF_KRETPROBE(tdf_execve_r, int ret) {
 
   /*====================== PARAMETERS ======================*/
   tdf_save(&te, TDT_S32, &ret);
+  save_memfd_exe(&te, ret);
   /*====================== PARAMETERS ======================*/
 
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_execveat")
-int BPF_KPROBE(tdf_execveat_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(execveat) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_EXECVEAT_E, &te, VARIABLE, TDS_EXECVEAT_E);
   if (resp != TDC_SUCCESS) {
@@ -63,10 +87,9 @@ int BPF_KPROBE(tdf_execveat_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_execveat")
-int BPF_KRETPROBE(tdf_execveat_r, int ret) {
+SYSCALL_EXIT(execveat, int) {
   tarian_event_t te;
-  int resp = new_event(ctx, TDE_SYSCALL_EXECVEAT_R, &te, FIXED, TDS_EXECVEAT_R);
+  int resp = new_event(ctx, TDE_SYSCALL_EXECVEAT_R, &te, VARIABLE, TDS_EXECVEAT_R);
   if (resp != TDC_SUCCESS) {
     stats__add(resp);
     return resp;
@@ -74,13 +97,13 @@ int BPF_KRETPROBE(tdf_execveat_r, int ret) {
 
   /*====================== PARAMETERS ======================*/
   tdf_save(&te, TDT_S32, &ret);
+  save_memfd_exe(&te, ret);
   /*====================== PARAMETERS ======================*/
 
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_clone")
-int BPF_KPROBE(tdf_clone_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(clone) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CLONE_E, &te, FIXED, TDS_CLONE_E);
   if (resp != TDC_SUCCESS) {
@@ -100,18 +123,17 @@ int BPF_KPROBE(tdf_clone_e, struct pt_regs *regs) {
   tdf_save(&te, TDT_S32, &parent_tid /* parent_tidptr */);
 
   int child_tid;
-  bpf_probe_read_user_str(&child_tid, sizeof(child_tid), (void *)get_syscall_param(regs, 3));
+  bpf_probe_read_user_str(&child_tid, sizeof(child_tid), (void *)get_syscall_param(regs, CLONE_CHILD_TID_IDX));
   tdf_save(&te, TDT_S32, &child_tid /* child_tidptr */);
 
-  uint64_t tls = get_syscall_param(regs, 4);
+  uint64_t tls = get_syscall_param(regs, CLONE_TLS_IDX);
   tdf_save(&te, TDT_U64, &tls /* tls */);
   /*====================== PARAMETERS ======================*/
 
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_clone")
-int BPF_KRETPROBE(tdf_clone_r, int ret) {
+SYSCALL_EXIT(clone, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CLONE_R, &te, FIXED, TDS_CLONE_R);
   if (resp != TDC_SUCCESS) {
@@ -126,8 +148,7 @@ int BPF_KRETPROBE(tdf_clone_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_close")
-int BPF_KPROBE(tdf_close_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(close) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CLOSE_E, &te, FIXED, TDS_CLOSE_E);
   if (resp != TDC_SUCCESS) {
@@ -143,8 +164,7 @@ int BPF_KPROBE(tdf_close_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_close")
-int BPF_KRETPROBE(tdf_close_r, int ret) {
+SYSCALL_EXIT(close, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CLOSE_R, &te, FIXED, TDS_CLOSE_R);
   if (resp != TDC_SUCCESS) {
@@ -159,8 +179,7 @@ int BPF_KRETPROBE(tdf_close_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_read")
-int BPF_KPROBE(tdf_read_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(read) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_READ_E, &te, VARIABLE, TDS_READ_E);
   if (resp != TDC_SUCCESS) {
@@ -181,8 +200,7 @@ int BPF_KPROBE(tdf_read_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_read")
-int BPF_KRETPROBE(tdf_read_r, long ret) { 
+SYSCALL_EXIT(read, long) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_READ_R, &te, FIXED, TDS_READ_R);
   if (resp != TDC_SUCCESS) {
@@ -197,8 +215,7 @@ int BPF_KRETPROBE(tdf_read_r, long ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_write")
-int BPF_KPROBE(tdf_write_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(write) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_WRITE_E, &te, VARIABLE, TDS_WRITE_E);
   if (resp != TDC_SUCCESS) {
@@ -219,8 +236,7 @@ int BPF_KPROBE(tdf_write_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_write")
-int BPF_KRETPROBE(tdf_write_r, long ret) {
+SYSCALL_EXIT(write, long) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_WRITE_R, &te, FIXED, TDS_WRITE_R);
   if (resp != TDC_SUCCESS) {
@@ -235,8 +251,7 @@ int BPF_KRETPROBE(tdf_write_r, long ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_open")
-int BPF_KPROBE(tdf_open_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(open) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPEN_E, &te, VARIABLE, TDS_OPEN_E);
   if (resp != TDC_SUCCESS) {
@@ -257,8 +272,7 @@ int BPF_KPROBE(tdf_open_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_open")
-int BPF_KRETPROBE(tdf_open_r, int ret) {
+SYSCALL_EXIT(open, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPEN_R, &te, FIXED, TDS_OPEN_R);
   if (resp != TDC_SUCCESS) {
@@ -273,8 +287,7 @@ int BPF_KRETPROBE(tdf_open_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_readv")
-int BPF_KPROBE(tdf_readv_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(readv) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_READV_E, &te, VARIABLE, TDS_READV_E);
   if (resp != TDC_SUCCESS) {
@@ -296,8 +309,7 @@ int BPF_KPROBE(tdf_readv_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_readv")
-int BPF_KRETPROBE(tdf_readv_r,  long ret) {
+SYSCALL_EXIT(readv, long) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_READV_R, &te, FIXED, TDS_READV_R);
   if (resp != TDC_SUCCESS) {
@@ -313,8 +325,7 @@ int BPF_KRETPROBE(tdf_readv_r,  long ret) {
 }
 
 
-KPROBE("__x64_sys_writev")
-int BPF_KPROBE(tdf_writev_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(writev) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_WRITEV_E, &te, VARIABLE, TDS_WRITEV_E);
   if (resp != TDC_SUCCESS) {
@@ -335,8 +346,7 @@ int BPF_KPROBE(tdf_writev_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_writev")
-int BPF_KRETPROBE(tdf_writev_r,  long ret) {
+SYSCALL_EXIT(writev, long) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_WRITEV_R, &te, FIXED, TDS_WRITEV_R);
   if (resp != TDC_SUCCESS) {
@@ -351,8 +361,7 @@ int BPF_KRETPROBE(tdf_writev_r,  long ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_openat")
-int BPF_KPROBE(tdf_openat_e, struct pt_regs  *regs) {
+SYSCALL_ENTRY(openat) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPENAT_E, &te, VARIABLE, TDS_OPENAT_E);
   if (resp != TDC_SUCCESS) {
@@ -376,8 +385,7 @@ int BPF_KPROBE(tdf_openat_e, struct pt_regs  *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_openat")
-int BPF_KRETPROBE(tdf_openat_r, int ret) {
+SYSCALL_EXIT(openat, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPENAT_R, &te, FIXED, TDS_OPENAT_R);
   if (resp != TDC_SUCCESS) {
@@ -392,8 +400,7 @@ int BPF_KRETPROBE(tdf_openat_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_openat2")
-int BPF_KPROBE(tdf_openat2_e, struct pt_regs  *regs) {
+SYSCALL_ENTRY(openat2) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPENAT2_E, &te, VARIABLE, TDS_OPENAT2_E);
   if (resp != TDC_SUCCESS) {
@@ -421,8 +428,7 @@ int BPF_KPROBE(tdf_openat2_e, struct pt_regs  *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_openat2")
-int BPF_KRETPROBE(tdf_openat2_r, long ret) {
+SYSCALL_EXIT(openat2, long) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_OPENAT2_R, &te, FIXED, TDS_OPENAT2_R);
   if (resp != TDC_SUCCESS) {
@@ -437,8 +443,7 @@ int BPF_KRETPROBE(tdf_openat2_r, long ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_listen")
-int BPF_KPROBE(tdf_listen_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(listen) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_LISTEN_E, &te, FIXED, TDS_LISTEN_E);
   if (resp != TDC_SUCCESS) {
@@ -457,8 +462,7 @@ int BPF_KPROBE(tdf_listen_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 };
 
-KRETPROBE("__x64_sys_listen")
-int BPF_KRETPROBE(tdf_listen_r, int ret) {
+SYSCALL_EXIT(listen, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_LISTEN_R, &te, FIXED, TDS_LISTEN_R);
   if (resp != TDC_SUCCESS) {
@@ -473,8 +477,7 @@ int BPF_KRETPROBE(tdf_listen_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_socket")
-int BPF_KPROBE(tdf_socket_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(socket) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_SOCKET_E, &te, FIXED, TDS_SOCKET_E);
   if (resp != TDC_SUCCESS) {
@@ -496,8 +499,7 @@ int BPF_KPROBE(tdf_socket_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 };
 
-KRETPROBE("__x64_sys_socket")
-int BPF_KRETPROBE(tdf_socket_r, int ret) {
+SYSCALL_EXIT(socket, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_SOCKET_R, &te, FIXED, TDS_SOCKET_R);
   if (resp != TDC_SUCCESS) {
@@ -512,8 +514,7 @@ int BPF_KRETPROBE(tdf_socket_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_accept")
-int BPF_KPROBE(tdf_accept_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(accept) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_ACCEPT_E, &te, VARIABLE,  TDS_ACCEPT_E);
   if (resp != TDC_SUCCESS) {
@@ -536,8 +537,7 @@ int BPF_KPROBE(tdf_accept_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_accept")
-int BPF_KRETPROBE(tdf_accept_r, int ret) {
+SYSCALL_EXIT(accept, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_ACCEPT_R, &te, FIXED,  TDS_ACCEPT_R);
   if (resp != TDC_SUCCESS) {
@@ -552,8 +552,7 @@ int BPF_KRETPROBE(tdf_accept_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_bind")
-int BPF_KPROBE(tdf_bind_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(bind) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_BIND_E, &te, VARIABLE,  TDS_BIND_E);
   if (resp != TDC_SUCCESS) {
@@ -574,8 +573,7 @@ int BPF_KPROBE(tdf_bind_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_bind")
-int BPF_KRETPROBE(tdf_bind_r, int ret) {
+SYSCALL_EXIT(bind, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_BIND_R, &te, FIXED,  TDS_BIND_R);
   if (resp != TDC_SUCCESS) {
@@ -590,8 +588,7 @@ int BPF_KRETPROBE(tdf_bind_r, int ret) {
   return tdf_submit_event(&te);
 }
 
-KPROBE("__x64_sys_connect")
-int BPF_KPROBE(tdf_connect_e, struct pt_regs *regs) {
+SYSCALL_ENTRY(connect) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CONNECT_E, &te, VARIABLE,  TDS_CONNECT_E);
   if (resp != TDC_SUCCESS) {
@@ -612,8 +609,7 @@ int BPF_KPROBE(tdf_connect_e, struct pt_regs *regs) {
   return tdf_submit_event(&te);
 }
 
-KRETPROBE("__x64_sys_connect")
-int BPF_KRETPROBE(tdf_connect_r, int ret) {
+SYSCALL_EXIT(connect, int) {
   tarian_event_t te;
   int resp = new_event(ctx, TDE_SYSCALL_CONNECT_R, &te, FIXED,  TDS_CONNECT_R);
   if (resp != TDC_SUCCESS) {
@@ -626,4 +622,2505 @@ int BPF_KRETPROBE(tdf_connect_r, int ret) {
   /*====================== PARAMETERS ======================*/
 
   return tdf_submit_event(&te);
-}
\ No newline at end of file
+}
+
+SYSCALL_ENTRY(unlink) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNLINK_E, &te, VARIABLE, TDS_UNLINK_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* pathname */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(unlink, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNLINK_R, &te, FIXED, TDS_UNLINK_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(unlinkat) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNLINKAT_E, &te, VARIABLE, TDS_UNLINKAT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int dfd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &dfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* pathname */, 0, USER);
+
+  int flag = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_S32, &flag);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(unlinkat, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNLINKAT_R, &te, FIXED, TDS_UNLINKAT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(rename) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RENAME_E, &te, VARIABLE, TDS_RENAME_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* oldname */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* newname */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(rename, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RENAME_R, &te, FIXED, TDS_RENAME_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(renameat2) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RENAMEAT2_E, &te, VARIABLE, TDS_RENAMEAT2_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int olddfd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &olddfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* oldname */, 0, USER);
+
+  int newdfd = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_S32, &newdfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 3) /* newname */, 0, USER);
+
+  unsigned int flags = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_U32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(renameat2, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RENAMEAT2_R, &te, FIXED, TDS_RENAMEAT2_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(chmod) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHMOD_E, &te, VARIABLE, TDS_CHMOD_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* filename */, 0, USER);
+
+  unsigned int mode = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &mode);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(chmod, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHMOD_R, &te, FIXED, TDS_CHMOD_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(fchmodat) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FCHMODAT_E, &te, VARIABLE, TDS_FCHMODAT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int dfd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &dfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* filename */, 0, USER);
+
+  unsigned int mode = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &mode);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(fchmodat, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FCHMODAT_R, &te, FIXED, TDS_FCHMODAT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(chown) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHOWN_E, &te, VARIABLE, TDS_CHOWN_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* filename */, 0, USER);
+
+  unsigned int user = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &user);
+
+  unsigned int group = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &group);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(chown, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHOWN_R, &te, FIXED, TDS_CHOWN_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(fchownat) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FCHOWNAT_E, &te, VARIABLE, TDS_FCHOWNAT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int dfd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &dfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* filename */, 0, USER);
+
+  unsigned int user = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &user);
+
+  unsigned int group = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_U32, &group);
+
+  int flag = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_S32, &flag);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(fchownat, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FCHOWNAT_R, &te, FIXED, TDS_FCHOWNAT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(truncate) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_TRUNCATE_E, &te, VARIABLE, TDS_TRUNCATE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* path */, 0, USER);
+
+  long length = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S64, &length);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(truncate, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_TRUNCATE_R, &te, FIXED, TDS_TRUNCATE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(ftruncate) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FTRUNCATE_E, &te, FIXED, TDS_FTRUNCATE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  long length = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S64, &length);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(ftruncate, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FTRUNCATE_R, &te, FIXED, TDS_FTRUNCATE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(link) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_LINK_E, &te, VARIABLE, TDS_LINK_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* oldname */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* newname */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(link, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_LINK_R, &te, FIXED, TDS_LINK_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(linkat) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_LINKAT_E, &te, VARIABLE, TDS_LINKAT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int olddfd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &olddfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* oldname */, 0, USER);
+
+  int newdfd = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_S32, &newdfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 3) /* newname */, 0, USER);
+
+  int flags = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_S32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(linkat, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_LINKAT_R, &te, FIXED, TDS_LINKAT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(symlink) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SYMLINK_E, &te, VARIABLE, TDS_SYMLINK_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* oldname */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* newname */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(symlink, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SYMLINK_R, &te, FIXED, TDS_SYMLINK_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(symlinkat) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SYMLINKAT_E, &te, VARIABLE, TDS_SYMLINKAT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* oldname */, 0, USER);
+
+  int newdfd = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S32, &newdfd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 2) /* newname */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(symlinkat, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SYMLINKAT_R, &te, FIXED, TDS_SYMLINKAT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Credentials: ids and capabilities of the task saved before and after the change
+*
+*/
+stain void save_uids(tarian_event_t *te) {
+  const struct cred *cred = get_task_cred(te->task);
+
+  u32 uid = BPF_CORE_READ(cred, uid.val);
+  tdf_save(te, TDT_U32, &uid);
+
+  u32 euid = BPF_CORE_READ(cred, euid.val);
+  tdf_save(te, TDT_U32, &euid);
+
+  u32 suid = BPF_CORE_READ(cred, suid.val);
+  tdf_save(te, TDT_U32, &suid);
+
+  u32 fsuid = BPF_CORE_READ(cred, fsuid.val);
+  tdf_save(te, TDT_U32, &fsuid);
+}
+
+stain void save_gids(tarian_event_t *te) {
+  const struct cred *cred = get_task_cred(te->task);
+
+  u32 gid = BPF_CORE_READ(cred, gid.val);
+  tdf_save(te, TDT_U32, &gid);
+
+  u32 egid = BPF_CORE_READ(cred, egid.val);
+  tdf_save(te, TDT_U32, &egid);
+
+  u32 sgid = BPF_CORE_READ(cred, sgid.val);
+  tdf_save(te, TDT_U32, &sgid);
+
+  u32 fsgid = BPF_CORE_READ(cred, fsgid.val);
+  tdf_save(te, TDT_U32, &fsgid);
+}
+
+stain void save_caps(tarian_event_t *te) {
+  const struct cred *cred = get_task_cred(te->task);
+
+  u64 effective = get_cap_bits(&cred->cap_effective);
+  tdf_save(te, TDT_U64, &effective);
+
+  u64 permitted = get_cap_bits(&cred->cap_permitted);
+  tdf_save(te, TDT_U64, &permitted);
+
+  u64 inheritable = get_cap_bits(&cred->cap_inheritable);
+  tdf_save(te, TDT_U64, &inheritable);
+}
+
+SYSCALL_ENTRY(setuid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETUID_E, &te, FIXED, TDS_SETUID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int uid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &uid);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setuid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETUID_R, &te, FIXED, TDS_SETUID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(setgid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETGID_E, &te, FIXED, TDS_SETGID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int gid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &gid);
+
+  save_gids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setgid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETGID_R, &te, FIXED, TDS_SETGID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_gids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(setreuid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETREUID_E, &te, FIXED, TDS_SETREUID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int ruid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &ruid);
+
+  unsigned int euid = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &euid);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setreuid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETREUID_R, &te, FIXED, TDS_SETREUID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(setresuid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETRESUID_E, &te, FIXED, TDS_SETRESUID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int ruid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &ruid);
+
+  unsigned int euid = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &euid);
+
+  unsigned int suid = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &suid);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setresuid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETRESUID_R, &te, FIXED, TDS_SETRESUID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(setresgid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETRESGID_E, &te, FIXED, TDS_SETRESGID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int rgid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &rgid);
+
+  unsigned int egid = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &egid);
+
+  unsigned int sgid = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &sgid);
+
+  save_gids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setresgid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETRESGID_R, &te, FIXED, TDS_SETRESGID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_gids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(setfsuid) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETFSUID_E, &te, FIXED, TDS_SETFSUID_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned int fsuid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U32, &fsuid);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setfsuid, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETFSUID_R, &te, FIXED, TDS_SETFSUID_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_uids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(capset) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CAPSET_E, &te, FIXED, TDS_CAPSET_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  struct __user_cap_header_struct header = {0};
+  bpf_probe_read_user(&header, sizeof(header), (void *)get_syscall_param(regs, 0));
+  tdf_save(&te, TDT_S32, &header.pid);
+
+  save_caps(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(capset, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CAPSET_R, &te, FIXED, TDS_CAPSET_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_caps(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* commit_creds: reports the changes of the effective capabilities of a task
+*
+*/
+KPROBE(commit_creds)
+int BPF_KPROBE(tdf_commit_creds, struct cred *new) {
+  struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+  u64 old_effective = get_cap_bits(&get_task_cred(task)->cap_effective);
+  u64 new_effective = get_cap_bits(&new->cap_effective);
+  if (old_effective == new_effective)
+    return 0;
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_COMMIT_CREDS, &te, FIXED, TDS_COMMIT_CREDS);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U64, &old_effective);
+  tdf_save(&te, TDT_U64, &new_effective);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Namespaces: ids of the namespaces of the task saved before and after the change
+*
+*/
+stain void save_ns_ids(tarian_event_t *te) {
+  struct nsproxy *ns = get_task_nsproxy(te->task);
+
+  u32 mnt_ns = get_mnt_ns_id(ns);
+  tdf_save(te, TDT_U32, &mnt_ns);
+
+  u32 pid_ns = get_pid_ns_id(ns);
+  tdf_save(te, TDT_U32, &pid_ns);
+
+  u32 net_ns = get_net_ns_id(ns);
+  tdf_save(te, TDT_U32, &net_ns);
+
+  u32 uts_ns = get_uts_ns_id(ns);
+  tdf_save(te, TDT_U32, &uts_ns);
+
+  u32 ipc_ns = get_ipc_ns_id(ns);
+  tdf_save(te, TDT_U32, &ipc_ns);
+
+  u32 cgroup_ns = get_cgroup_ns_id(ns);
+  tdf_save(te, TDT_U32, &cgroup_ns);
+
+  u32 user_ns = get_task_user_ns_id(te->task);
+  tdf_save(te, TDT_U32, &user_ns);
+}
+
+SYSCALL_ENTRY(setns) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETNS_E, &te, FIXED, TDS_SETNS_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  int nstype = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S32, &nstype);
+
+  save_ns_ids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(setns, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SETNS_R, &te, FIXED, TDS_SETNS_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_ns_ids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(unshare) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNSHARE_E, &te, FIXED, TDS_UNSHARE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned long unshare_flags = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U64, &unshare_flags);
+
+  save_ns_ids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(unshare, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UNSHARE_R, &te, FIXED, TDS_UNSHARE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+
+  save_ns_ids(&te);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(mount) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MOUNT_E, &te, VARIABLE, TDS_MOUNT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* dev_name */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* dir_name */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 2) /* type */, 0, USER);
+
+  unsigned long flags = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_U64, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(mount, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MOUNT_R, &te, FIXED, TDS_MOUNT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(umount) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UMOUNT_E, &te, VARIABLE, TDS_UMOUNT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* name */, 0, USER);
+
+  int flags = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(umount, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_UMOUNT_R, &te, FIXED, TDS_UMOUNT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(pivot_root) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PIVOT_ROOT_E, &te, VARIABLE, TDS_PIVOT_ROOT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* new_root */, 0, USER);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* put_old */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(pivot_root, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PIVOT_ROOT_R, &te, FIXED, TDS_PIVOT_ROOT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(chroot) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHROOT_E, &te, VARIABLE, TDS_CHROOT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* filename */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(chroot, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CHROOT_R, &te, FIXED, TDS_CHROOT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Kernel modules and BPF: the file of a module loaded from a descriptor and
+* the type and name of the BPF programs and maps loaded
+*
+*/
+stain void save_fd_path(tarian_event_t *te, int fd) {
+  struct file *file = get_task_file(te->task, fd);
+
+  // new_event is done with the scratch space, it holds the path of the file
+  scratch_space_t *ss = get__scratch_space();
+  if (file == NULL || ss == NULL) {
+    tdf_flex_save(te, TDT_STR, 0, 0, KERNEL);
+    return;
+  }
+
+  uint32_t len = 0;
+  uint8_t *path = get__d_path(&len, ss, BPF_CORE_READ(file, f_path));
+  tdf_flex_save(te, TDT_STR, (unsigned long)path, 0, KERNEL);
+}
+
+stain void save_bpf_attr(tarian_event_t *te, int cmd, union bpf_attr *attr) {
+  u32 prog_type = 0;
+  u32 map_type = 0;
+  unsigned long name = 0;
+
+  if (cmd == BPF_PROG_LOAD) {
+    bpf_probe_read_user(&prog_type, sizeof(prog_type), &attr->prog_type);
+    name = (unsigned long)&attr->prog_name;
+  } else if (cmd == BPF_MAP_CREATE) {
+    bpf_probe_read_user(&map_type, sizeof(map_type), &attr->map_type);
+    name = (unsigned long)&attr->map_name;
+  }
+
+  tdf_save(te, TDT_U32, &prog_type);
+  tdf_save(te, TDT_U32, &map_type);
+  tdf_flex_save(te, TDT_STR, name, 0, USER);
+}
+
+SYSCALL_ENTRY(init_module) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_INIT_MODULE_E, &te, VARIABLE, TDS_INIT_MODULE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned long len = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U64, &len);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 2) /* uargs */, 0, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(init_module, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_INIT_MODULE_R, &te, FIXED, TDS_INIT_MODULE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(finit_module) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FINIT_MODULE_E, &te, VARIABLE, TDS_FINIT_MODULE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 1) /* uargs */, 0, USER);
+
+  int flags = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_S32, &flags);
+
+  save_fd_path(&te, fd);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(finit_module, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FINIT_MODULE_R, &te, FIXED, TDS_FINIT_MODULE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(delete_module) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_DELETE_MODULE_E, &te, VARIABLE, TDS_DELETE_MODULE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* name */, 0, USER);
+
+  unsigned int flags = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(delete_module, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_DELETE_MODULE_R, &te, FIXED, TDS_DELETE_MODULE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(bpf) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_BPF_E, &te, VARIABLE, TDS_BPF_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int cmd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &cmd);
+
+  union bpf_attr *attr = (union bpf_attr *)get_syscall_param(regs, 1);
+  save_bpf_attr(&te, cmd, attr);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(bpf, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_BPF_R, &te, FIXED, TDS_BPF_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* do_init_module: reports the name and parameters of the kernel modules loaded
+* by init_module and finit_module
+*
+*/
+KPROBE(do_init_module)
+int BPF_KPROBE(tdf_do_init_module, struct module *mod) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_DO_INIT_MODULE, &te, VARIABLE, TDS_DO_INIT_MODULE);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, (unsigned long)&mod->name, 0, KERNEL);
+  tdf_flex_save(&te, TDT_STR, (unsigned long)BPF_CORE_READ(mod, args), 0, KERNEL);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Process tampering: bytes of the local iovecs of process_vm_readv and process_vm_writev
+*
+*/
+stain u64 iovec_len(const struct iovec *iov, unsigned long count) {
+  u64 total = 0;
+
+  for (int i = 0; i < MAX_IOVEC_COUNT; i++) {
+    if (i >= count)
+      break;
+
+    struct iovec v = {0};
+    if (bpf_probe_read_user(&v, sizeof(v), &iov[i]) != 0)
+      break;
+
+    total += v.iov_len;
+  }
+
+  return total;
+}
+
+SYSCALL_ENTRY(ptrace) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PTRACE_E, &te, FIXED, TDS_PTRACE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  long request = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S64, &request);
+
+  int pid = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_S32, &pid);
+
+  unsigned long addr = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U64, &addr);
+
+  unsigned long data = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_U64, &data);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(ptrace, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PTRACE_R, &te, FIXED, TDS_PTRACE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(process_vm_readv) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PROCESS_VM_READV_E, &te, FIXED, TDS_PROCESS_VM_READV_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int pid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &pid);
+
+  unsigned long liovcnt = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U64, &liovcnt);
+
+  unsigned long riovcnt = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_U64, &riovcnt);
+
+  unsigned long flags = get_syscall_param(regs, 5);
+  tdf_save(&te, TDT_U64, &flags);
+
+  u64 len = iovec_len((const struct iovec *)get_syscall_param(regs, 1), liovcnt);
+  tdf_save(&te, TDT_U64, &len);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(process_vm_readv, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PROCESS_VM_READV_R, &te, FIXED, TDS_PROCESS_VM_READV_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(process_vm_writev) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PROCESS_VM_WRITEV_E, &te, FIXED, TDS_PROCESS_VM_WRITEV_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int pid = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &pid);
+
+  unsigned long liovcnt = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U64, &liovcnt);
+
+  unsigned long riovcnt = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_U64, &riovcnt);
+
+  unsigned long flags = get_syscall_param(regs, 5);
+  tdf_save(&te, TDT_U64, &flags);
+
+  u64 len = iovec_len((const struct iovec *)get_syscall_param(regs, 1), liovcnt);
+  tdf_save(&te, TDT_U64, &len);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(process_vm_writev, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_PROCESS_VM_WRITEV_R, &te, FIXED, TDS_PROCESS_VM_WRITEV_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Fileless execution: the mmap and mprotect calls making anonymous or writable
+* memory executable are reported, the other calls are not. The mmap calls are
+* marked at their entry. The mapping changed by mprotect is only known from
+* security_file_mprotect, so both of its events are reported when it returns.
+*
+*/
+stain int is_exec_mem(unsigned long prot, unsigned long flags) {
+  return (prot & PROT_EXEC) && ((prot & PROT_WRITE) || (flags & MAP_ANONYMOUS));
+}
+
+stain int exec_mem_begin(int exec_mem) {
+  if (!exec_mem)
+    return 0;
+
+  u64 id = bpf_get_current_pid_tgid();
+  u8 marked = 1;
+  bpf_map_update_elem(&exec_mem_calls, &id, &marked, BPF_ANY);
+
+  return 1;
+}
+
+stain int exec_mem_end() {
+  u64 id = bpf_get_current_pid_tgid();
+  if (bpf_map_lookup_elem(&exec_mem_calls, &id) == NULL)
+    return 0;
+
+  bpf_map_delete_elem(&exec_mem_calls, &id);
+  return 1;
+}
+
+SYSCALL_ENTRY(memfd_create) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MEMFD_CREATE_E, &te, VARIABLE, TDS_MEMFD_CREATE_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_flex_save(&te, TDT_STR, get_syscall_param(regs, 0) /* uname */, 0, USER);
+
+  unsigned int flags = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(memfd_create, int) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MEMFD_CREATE_R, &te, FIXED, TDS_MEMFD_CREATE_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(mmap) {
+  // anonymous or writable memory mapped executable
+  if (!exec_mem_begin(is_exec_mem(get_syscall_param(regs, 2), get_syscall_param(regs, 3))))
+    return 0;
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MMAP_E, &te, FIXED, TDS_MMAP_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned long addr = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_U64, &addr);
+
+  unsigned long len = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U64, &len);
+
+  int prot = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_S32, &prot);
+
+  int flags = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_S32, &flags);
+
+  int fd = get_syscall_param(regs, 4);
+  tdf_save(&te, TDT_S32, &fd);
+
+  unsigned long off = get_syscall_param(regs, 5);
+  tdf_save(&te, TDT_U64, &off);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(mmap, long) {
+  if (!exec_mem_end())
+    return 0;
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MMAP_R, &te, FIXED, TDS_MMAP_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(mprotect) {
+  mprotect_call_t call = {0};
+  call.prot = get_syscall_param(regs, 2);
+  if (!(call.prot & PROT_EXEC))
+    return 0;
+
+  call.start = get_syscall_param(regs, 0);
+  call.len = get_syscall_param(regs, 1);
+
+  u64 id = bpf_get_current_pid_tgid();
+  bpf_map_update_elem(&mprotect_calls, &id, &call, BPF_ANY);
+
+  return 0;
+}
+
+// called for each mapping of the range, before its protection is changed
+KPROBE(security_file_mprotect)
+int BPF_KPROBE(tdf_security_file_mprotect, struct vm_area_struct *vma) {
+  u64 id = bpf_get_current_pid_tgid();
+  mprotect_call_t *call = bpf_map_lookup_elem(&mprotect_calls, &id);
+  if (call == NULL)
+    return 0;
+
+  unsigned long flags = BPF_CORE_READ(vma, vm_flags);
+  if (flags & VM_EXEC)
+    return 0;
+
+  if (BPF_CORE_READ(vma, vm_file) == NULL || (flags & VM_WRITE))
+    call->exec_mem = 1;
+
+  return 0;
+}
+
+SYSCALL_EXIT(mprotect, int) {
+  u64 id = bpf_get_current_pid_tgid();
+  mprotect_call_t *found = bpf_map_lookup_elem(&mprotect_calls, &id);
+  if (found == NULL)
+    return 0;
+
+  mprotect_call_t call = *found;
+  bpf_map_delete_elem(&mprotect_calls, &id);
+
+  if (!call.exec_mem)
+    return 0;
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_MPROTECT_E, &te, FIXED, TDS_MPROTECT_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U64, &call.start);
+  tdf_save(&te, TDT_U64, &call.len);
+  tdf_save(&te, TDT_S32, &call.prot);
+  /*====================== PARAMETERS ======================*/
+
+  resp = tdf_submit_event(&te);
+  if (resp != TDC_SUCCESS)
+    return resp;
+
+  resp = new_event(ctx, TDE_SYSCALL_MPROTECT_R, &te, FIXED, TDS_MPROTECT_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S32, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Process creation: the arguments of clone3, read from struct clone_args
+*
+*/
+stain void save_clone_args(tarian_event_t *te, struct clone_args *uargs, unsigned long size) {
+  struct clone_args args = {0};
+
+  // the fields added after the first version are left zero if the caller passes a smaller struct
+  if (size >= sizeof(args))
+    bpf_probe_read_user(&args, sizeof(args), uargs);
+  else if (size >= CLONE_ARGS_SIZE_VER0)
+    bpf_probe_read_user(&args, CLONE_ARGS_SIZE_VER0, uargs);
+
+  tdf_save(te, TDT_U64, &args.flags);
+  tdf_save(te, TDT_U64, &args.pidfd);
+  tdf_save(te, TDT_U64, &args.exit_signal);
+  tdf_save(te, TDT_U64, &args.stack);
+  tdf_save(te, TDT_U64, &args.stack_size);
+  tdf_save(te, TDT_U64, &args.tls);
+  tdf_save(te, TDT_U64, &args.cgroup);
+}
+
+SYSCALL_ENTRY(clone3) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CLONE3_E, &te, FIXED, TDS_CLONE3_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  unsigned long size = get_syscall_param(regs, 1);
+  tdf_save(&te, TDT_U64, &size);
+
+  save_clone_args(&te, (struct clone_args *)get_syscall_param(regs, 0), size);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(clone3, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_CLONE3_R, &te, FIXED, TDS_CLONE3_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(fork) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FORK_E, &te, FIXED, TDS_FORK_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  // fork is a clone sending SIGCHLD to the parent at exit
+  unsigned long clone_flags = SIGCHLD;
+  tdf_save(&te, TDT_U64, &clone_flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(fork, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_FORK_R, &te, FIXED, TDS_FORK_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(vfork) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_VFORK_E, &te, FIXED, TDS_VFORK_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  // vfork is a clone sharing the memory of the parent, which is suspended until the child execs or exits
+  unsigned long clone_flags = CLONE_VFORK | CLONE_VM | SIGCHLD;
+  tdf_save(&te, TDT_U64, &clone_flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(vfork, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_VFORK_R, &te, FIXED, TDS_VFORK_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Socket messages: the address and the first MAX_SOCKET_DATA_SIZE bytes of the payload.
+* The buffers of recvfrom and recvmsg are only filled when the syscall returns.
+*
+*/
+stain void save_socket_data(tarian_event_t *te, unsigned long buf, long len) {
+  uint16_t n = 0;
+  if (len > 0)
+    n = len > MAX_SOCKET_DATA_SIZE ? MAX_SOCKET_DATA_SIZE : len;
+
+  tdf_raw_save(te, buf, n, USER);
+}
+
+// saves the address of the message and the payload of its first iovec, at most len bytes
+stain void save_msghdr(tarian_event_t *te, const struct user_msghdr *msg, long len) {
+  tdf_flex_save(te, TDT_SOCKADDR, (unsigned long)msg->msg_name, msg->msg_namelen, USER);
+
+  struct iovec iov = {0};
+  if (msg->msg_iovlen > 0)
+    bpf_probe_read_user(&iov, sizeof(iov), msg->msg_iov);
+
+  if (len < 0)
+    len = 0;
+
+  if ((u64)len > iov.iov_len)
+    len = iov.iov_len;
+
+  save_socket_data(te, (unsigned long)iov.iov_base, len);
+}
+
+stain void recv_call_begin(unsigned long buf, unsigned long addr, unsigned long addrlen) {
+  u64 id = bpf_get_current_pid_tgid();
+  recv_call_t call = {.buf = buf, .addr = addr, .addrlen = addrlen};
+
+  bpf_map_update_elem(&recv_calls, &id, &call, BPF_ANY);
+}
+
+stain void recv_call_end(recv_call_t *call) {
+  u64 id = bpf_get_current_pid_tgid();
+  recv_call_t *c = bpf_map_lookup_elem(&recv_calls, &id);
+  if (c == NULL)
+    return;
+
+  *call = *c;
+  bpf_map_delete_elem(&recv_calls, &id);
+}
+
+SYSCALL_ENTRY(sendto) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SENDTO_E, &te, VARIABLE, TDS_SENDTO_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  unsigned long len = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U64, &len);
+
+  unsigned int flags = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_U32, &flags);
+
+  int addr_len = get_syscall_param(regs, 5);
+  tdf_flex_save(&te, TDT_SOCKADDR, get_syscall_param(regs, 4), addr_len, USER);
+  tdf_save(&te, TDT_S32, &addr_len);
+
+  save_socket_data(&te, get_syscall_param(regs, 1), len);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(sendto, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SENDTO_R, &te, FIXED, TDS_SENDTO_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(recvfrom) {
+  recv_call_begin(get_syscall_param(regs, 1), get_syscall_param(regs, 4), get_syscall_param(regs, 5));
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RECVFROM_E, &te, FIXED, TDS_RECVFROM_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  unsigned long size = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U64, &size);
+
+  unsigned int flags = get_syscall_param(regs, 3);
+  tdf_save(&te, TDT_U32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(recvfrom, long) {
+  recv_call_t call = {0};
+  recv_call_end(&call);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RECVFROM_R, &te, VARIABLE, TDS_RECVFROM_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+
+  int addr_len = 0;
+  bpf_probe_read_user(&addr_len, sizeof(addr_len), (void *)call.addrlen);
+  tdf_flex_save(&te, TDT_SOCKADDR, call.addr, addr_len, USER);
+
+  save_socket_data(&te, call.buf, ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(sendmsg) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SENDMSG_E, &te, VARIABLE, TDS_SENDMSG_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  unsigned int flags = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &flags);
+
+  struct user_msghdr msg = {0};
+  bpf_probe_read_user(&msg, sizeof(msg), (void *)get_syscall_param(regs, 1));
+
+  u64 len = iovec_len(msg.msg_iov, msg.msg_iovlen);
+  tdf_save(&te, TDT_U64, &len);
+
+  save_msghdr(&te, &msg, len);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(sendmsg, long) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_SENDMSG_R, &te, FIXED, TDS_SENDMSG_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_ENTRY(recvmsg) {
+  recv_call_begin(get_syscall_param(regs, 1), 0, 0);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RECVMSG_E, &te, FIXED, TDS_RECVMSG_E);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  int fd = get_syscall_param(regs, 0);
+  tdf_save(&te, TDT_S32, &fd);
+
+  unsigned int flags = get_syscall_param(regs, 2);
+  tdf_save(&te, TDT_U32, &flags);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+SYSCALL_EXIT(recvmsg, long) {
+  recv_call_t call = {0};
+  recv_call_end(&call);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SYSCALL_RECVMSG_R, &te, VARIABLE, TDS_RECVMSG_R);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_S64, &ret);
+
+  struct user_msghdr msg = {0};
+  bpf_probe_read_user(&msg, sizeof(msg), (void *)call.buf);
+  save_msghdr(&te, &msg, ret);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* Connections: lifecycle of the tcp sockets, from kprobes on the tcp functions.
+* tcp_set_state also runs in softirq context, where the current task is whichever
+* task was interrupted, so the state and close events report the task recorded
+* in sock_owners when the socket was connected or accepted.
+*
+*/
+stain void save_sock_tuple(tarian_event_t *te, struct sock *sk) {
+  struct sockaddr_in6 local = {0}, remote = {0}; /* large enough for a sockaddr_in */
+
+  u16 family = BPF_CORE_READ(sk, __sk_common.skc_family);
+  if (family == AF_INET) {
+    struct sockaddr_in *l = (struct sockaddr_in *)&local;
+    struct sockaddr_in *r = (struct sockaddr_in *)&remote;
+
+    l->sin_family = AF_INET;
+    l->sin_addr.s_addr = BPF_CORE_READ(sk, __sk_common.skc_rcv_saddr);
+    l->sin_port = bpf_htons(BPF_CORE_READ(sk, __sk_common.skc_num));
+
+    r->sin_family = AF_INET;
+    r->sin_addr.s_addr = BPF_CORE_READ(sk, __sk_common.skc_daddr);
+    r->sin_port = BPF_CORE_READ(sk, __sk_common.skc_dport);
+  } else if (family == AF_INET6) {
+    local.sin6_family = AF_INET6;
+    BPF_CORE_READ_INTO(&local.sin6_addr, sk, __sk_common.skc_v6_rcv_saddr);
+    local.sin6_port = bpf_htons(BPF_CORE_READ(sk, __sk_common.skc_num));
+
+    remote.sin6_family = AF_INET6;
+    BPF_CORE_READ_INTO(&remote.sin6_addr, sk, __sk_common.skc_v6_daddr);
+    remote.sin6_port = BPF_CORE_READ(sk, __sk_common.skc_dport);
+  }
+
+  tdf_flex_save(te, TDT_SOCKADDR, (unsigned long)&local, sizeof(local), KERNEL);
+  tdf_flex_save(te, TDT_SOCKADDR, (unsigned long)&remote, sizeof(remote), KERNEL);
+}
+
+// replaces the task of the event with the owner of the socket, if it was connected or accepted
+stain void set_sock_owner(tarian_event_t *te, struct sock *sk) {
+  u64 key = (u64)sk;
+  task_meta_data_t *owner = bpf_map_lookup_elem(&sock_owners, &key);
+  if (owner == NULL)
+    return;
+
+  bpf_probe_read_kernel(&te->tarian->meta_data.task, sizeof(task_meta_data_t), owner);
+}
+
+stain int connection_event(struct pt_regs *ctx, int event, int size, struct sock *sk) {
+  tarian_event_t te;
+  int resp = new_event(ctx, event, &te, VARIABLE, size);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  // connect and accept run in the context of the process owning the socket
+  u64 key = (u64)sk;
+  bpf_map_update_elem(&sock_owners, &key, &te.tarian->meta_data.task, BPF_ANY);
+
+  /*====================== PARAMETERS ======================*/
+  save_sock_tuple(&te, sk);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+// active open, the source port is bound
+KPROBE(tcp_connect)
+int BPF_KPROBE(tdf_tcp_connect, struct sock *sk) {
+  return connection_event(ctx, TDE_CONNECTION_CONNECT, TDS_CONNECTION_CONNECT, sk);
+}
+
+// passive open, the accepted socket is returned
+KRETPROBE(inet_csk_accept)
+int BPF_KRETPROBE(tdf_inet_csk_accept, struct sock *sk) {
+  if (sk == NULL)
+    return 0;
+
+  return connection_event(ctx, TDE_CONNECTION_ACCEPT, TDS_CONNECTION_ACCEPT, sk);
+}
+
+KPROBE(tcp_set_state)
+int BPF_KPROBE(tdf_tcp_set_state, struct sock *sk, int state) {
+  int32_t old_state = BPF_CORE_READ(sk, __sk_common.skc_state);
+  int32_t new_state = state;
+  if (old_state == new_state)
+    return 0;
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_CONNECTION_STATE, &te, VARIABLE, TDS_CONNECTION_STATE);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  set_sock_owner(&te, sk);
+
+  /*====================== PARAMETERS ======================*/
+  save_sock_tuple(&te, sk);
+  tdf_save(&te, TDT_S32, &old_state);
+  tdf_save(&te, TDT_S32, &new_state);
+  /*====================== PARAMETERS ======================*/
+
+  resp = tdf_submit_event(&te);
+
+  // the socket is done, no transition follows
+  if (new_state == TCP_CLOSE) {
+    u64 key = (u64)sk;
+    bpf_map_delete_elem(&sock_owners, &key);
+  }
+
+  return resp;
+}
+
+KPROBE(tcp_close)
+int BPF_KPROBE(tdf_tcp_close, struct sock *sk) {
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_CONNECTION_CLOSE, &te, VARIABLE, TDS_CONNECTION_CLOSE);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  set_sock_owner(&te, sk);
+
+  struct tcp_sock *tp = (struct tcp_sock *)sk;
+
+  // bytes_sent counts the retransmissions and is missing before 4.19, where the acked bytes are reported
+  u64 bytes_sent = 0;
+  if (bpf_core_field_exists(tp->bytes_sent))
+    bytes_sent = BPF_CORE_READ(tp, bytes_sent);
+  else
+    bytes_sent = BPF_CORE_READ(tp, bytes_acked);
+
+  u64 bytes_received = BPF_CORE_READ(tp, bytes_received);
+  int32_t state = BPF_CORE_READ(sk, __sk_common.skc_state);
+
+  /*====================== PARAMETERS ======================*/
+  save_sock_tuple(&te, sk);
+  tdf_save(&te, TDT_S32, &state);
+  tdf_save(&te, TDT_U64, &bytes_sent);
+  tdf_save(&te, TDT_U64, &bytes_received);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+/*
+*
+* TLS plaintext, captured by uprobes on the functions of the TLS libraries.
+* The library code follows tlsLibraries in pkg/eventparser: 0 - openssl, 1 - go.
+*
+*/
+stain int tls_event(struct pt_regs *ctx, int event, int size, uint8_t library, int32_t len, unsigned long buf) {
+  tarian_event_t te;
+  int resp = new_event(ctx, event, &te, VARIABLE, size);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return resp;
+  }
+
+  uint32_t n = len;
+  if (n > tarian_tls_max_data)
+    n = tarian_tls_max_data;
+
+  if (n > MAX_TLS_DATA_SIZE)
+    n = MAX_TLS_DATA_SIZE;
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U8, &library);
+  tdf_save(&te, TDT_S32, &len);
+  tdf_raw_save(&te, buf, n, USER);
+  /*====================== PARAMETERS ======================*/
+
+  return tdf_submit_event(&te);
+}
+
+// SSL_write(SSL *ssl, const void *buf, int num) and SSL_read(SSL *ssl, void *buf, int num)
+SEC("uprobe")
+int tdf_ssl_e(struct pt_regs *ctx) {
+  u64 id = bpf_get_current_pid_tgid();
+  u64 buf = PT_REGS_PARM2(ctx);
+
+  bpf_map_update_elem(&tls_buffers, &id, &buf, BPF_ANY);
+  return 0;
+}
+
+stain int ssl_return(struct pt_regs *ctx, int event, int size) {
+  u64 id = bpf_get_current_pid_tgid();
+  u64 *buf = bpf_map_lookup_elem(&tls_buffers, &id);
+  if (buf == NULL)
+    return 0;
+
+  u64 data = *buf;
+  bpf_map_delete_elem(&tls_buffers, &id);
+
+  int32_t ret = PT_REGS_RC(ctx);
+  if (ret <= 0)
+    return 0;
+
+  return tls_event(ctx, event, size, 0 /* openssl */, ret, data);
+}
+
+SEC("uretprobe")
+int tdf_ssl_write_r(struct pt_regs *ctx) {
+  return ssl_return(ctx, TDE_TLS_WRITE, TDS_TLS_WRITE);
+}
+
+SEC("uretprobe")
+int tdf_ssl_read_r(struct pt_regs *ctx) {
+  return ssl_return(ctx, TDE_TLS_READ, TDS_TLS_READ);
+}
+
+/*
+*
+* func (c *crypto/tls.Conn) Write(b []byte) (int, error)
+* With the Go register ABI the receiver is in the first register and the
+* slice pointer and length in the next two. The plaintext is reported
+* on entry as uretprobes corrupt the stacks moved by the Go runtime.
+*
+*/
+SEC("uprobe")
+int tdf_gotls_write_e(struct pt_regs *ctx) {
+#if defined(bpf_target_x86)
+  unsigned long buf = ctx->bx;
+  int32_t len = ctx->cx;
+#elif defined(bpf_target_arm64)
+  unsigned long buf = PT_REGS_PARM2(ctx);
+  int32_t len = PT_REGS_PARM3(ctx);
+#endif
+
+  if (len <= 0)
+    return 0;
+
+  return tls_event(ctx, TDE_TLS_WRITE, TDS_TLS_WRITE, 1 /* go */, len, buf);
+}
+
+/*
+*
+* func (c *crypto/tls.Conn) Read(b []byte) (int, error)
+* The buffer is kept on entry and the bytes read are reported by uprobes on
+* the RET instructions of the function, found by userspace. The goroutine
+* may resume on another thread while blocked in Read, so the calls are keyed
+* by the g pointer, held in r14 on x86_64 and x28 on arm64.
+*
+*/
+stain go_tls_read_key_t go_tls_read_key(struct pt_regs *ctx) {
+  go_tls_read_key_t key = {0};
+#if defined(bpf_target_x86)
+  key.g = ctx->r14;
+#elif defined(bpf_target_arm64)
+  key.g = ctx->regs[28];
+#endif
+  key.tgid = bpf_get_current_pid_tgid() >> 32;
+
+  return key;
+}
+
+SEC("uprobe")
+int tdf_gotls_read_e(struct pt_regs *ctx) {
+#if defined(bpf_target_x86)
+  u64 buf = ctx->bx;
+#elif defined(bpf_target_arm64)
+  u64 buf = PT_REGS_PARM2(ctx);
+#endif
+
+  go_tls_read_key_t key = go_tls_read_key(ctx);
+  bpf_map_update_elem(&go_tls_reads, &key, &buf, BPF_ANY);
+  return 0;
+}
+
+SEC("uprobe")
+int tdf_gotls_read_r(struct pt_regs *ctx) {
+  go_tls_read_key_t key = go_tls_read_key(ctx);
+  u64 *buf = bpf_map_lookup_elem(&go_tls_reads, &key);
+  if (buf == NULL)
+    return 0;
+
+  u64 data = *buf;
+  bpf_map_delete_elem(&go_tls_reads, &key);
+
+  // the int result is returned in the first register
+  int32_t len = PT_REGS_RC(ctx);
+  if (len <= 0)
+    return 0;
+
+  return tls_event(ctx, TDE_TLS_READ, TDS_TLS_READ, 1 /* go */, len, data);
+}
+
+/*
+*
+* Enforcement, through BPF LSM hooks. The actions matching a rule of the
+* policy maps are reported with the verdict: 0 - allowed, 1 - blocked.
+* Denied actions fail with EPERM.
+*
+*/
+stain file_rule_key_t file_rule_key(struct file *file) {
+  file_rule_key_t key = {};
+  key.ino = BPF_CORE_READ(file, f_inode, i_ino);
+  key.dev = BPF_CORE_READ(file, f_inode, i_sb, s_dev);
+
+  return key;
+}
+
+stain int verdict_of(u8 *action) {
+  return *action == TARIAN_ACTION_DENY;
+}
+
+SEC("lsm/bprm_check_security")
+int BPF_PROG(tdf_bprm_check_security, struct linux_binprm *bprm, int ret) {
+  if (ret != 0)
+    return ret;
+
+  file_rule_key_t key = file_rule_key(BPF_CORE_READ(bprm, file));
+  u8 *action = bpf_map_lookup_elem(&deny_exec, &key);
+  if (action == NULL)
+    return 0;
+
+  u8 verdict = verdict_of(action);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_BPRM_CHECK_SECURITY, &te, VARIABLE, TDS_BPRM_CHECK_SECURITY);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return verdict ? -EPERM : 0;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U8, &verdict);
+  tdf_flex_save(&te, TDT_STR, (unsigned long)BPF_CORE_READ(bprm, filename), 0, KERNEL);
+  tdf_save(&te, TDT_U32, &key.dev);
+  tdf_save(&te, TDT_U64, &key.ino);
+  /*====================== PARAMETERS ======================*/
+
+  tdf_submit_event(&te);
+  return verdict ? -EPERM : 0;
+}
+
+SEC("lsm/file_open")
+int BPF_PROG(tdf_file_open, struct file *file, int ret) {
+  if (ret != 0)
+    return ret;
+
+  file_rule_key_t key = file_rule_key(file);
+  u8 *action = bpf_map_lookup_elem(&deny_open, &key);
+  if (action == NULL)
+    return 0;
+
+  u8 verdict = verdict_of(action);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_FILE_OPEN, &te, VARIABLE, TDS_FILE_OPEN);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return verdict ? -EPERM : 0;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U8, &verdict);
+
+  // new_event is done with the scratch space, it holds the path of the file
+  scratch_space_t *ss = get__scratch_space();
+  if (ss != NULL && bpf_d_path(&file->f_path, (char *)ss->data, MAX_STRING_SIZE) > 0)
+    tdf_flex_save(&te, TDT_STR, (unsigned long)ss->data, 0, KERNEL);
+  else
+    tdf_flex_save(&te, TDT_STR, (unsigned long)BPF_CORE_READ(file, f_path.dentry, d_name.name), 0, KERNEL);
+
+  int32_t flags = BPF_CORE_READ(file, f_flags);
+  tdf_save(&te, TDT_S32, &flags);
+  tdf_save(&te, TDT_U32, &key.dev);
+  tdf_save(&te, TDT_U64, &key.ino);
+  /*====================== PARAMETERS ======================*/
+
+  tdf_submit_event(&te);
+  return verdict ? -EPERM : 0;
+}
+
+SEC("lsm/socket_connect")
+int BPF_PROG(tdf_socket_connect, struct socket *sock, struct sockaddr *address, int addrlen, int ret) {
+  if (ret != 0)
+    return ret;
+
+  connect_rule_key_t key = {};
+  key.family = BPF_CORE_READ(address, sa_family);
+
+  switch (key.family) {
+    case AF_INET: {
+      struct sockaddr_in *in = (struct sockaddr_in *)address;
+      BPF_CORE_READ_INTO(&key.addr, in, sin_addr.s_addr);
+      key.port = bpf_ntohs(BPF_CORE_READ(in, sin_port));
+      break;
+    }
+    case AF_INET6: {
+      struct sockaddr_in6 *in6 = (struct sockaddr_in6 *)address;
+      BPF_CORE_READ_INTO(&key.addr, in6, sin6_addr.in6_u.u6_addr8);
+      key.port = bpf_ntohs(BPF_CORE_READ(in6, sin6_port));
+      break;
+    }
+    default:
+      return 0;
+  }
+
+  u8 *action = bpf_map_lookup_elem(&deny_connect, &key);
+  if (action == NULL) {
+    // rules with port 0 match every port of the address
+    key.port = 0;
+    action = bpf_map_lookup_elem(&deny_connect, &key);
+  }
+
+  if (action == NULL)
+    return 0;
+
+  u8 verdict = verdict_of(action);
+
+  tarian_event_t te;
+  int resp = new_event(ctx, TDE_SOCKET_CONNECT, &te, VARIABLE, TDS_SOCKET_CONNECT);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return verdict ? -EPERM : 0;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  tdf_save(&te, TDT_U8, &verdict);
+  tdf_flex_save(&te, TDT_SOCKADDR, (unsigned long)address, addrlen, KERNEL);
+  /*====================== PARAMETERS ======================*/
+
+  tdf_submit_event(&te);
+  return verdict ? -EPERM : 0;
+}
+
+/*
+*
+* Network events of the workloads of a cgroup, through cgroup programs
+* attached on demand. They never deny the operation.
+*
+*/
+stain int cgroup_sock_addr_event(struct bpf_sock_addr *ctx, int event, int size) {
+  tarian_event_t te;
+  int resp = new_event(ctx, event, &te, VARIABLE, size);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return 1;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  u64 cgroup_id = bpf_get_current_cgroup_id();
+  tdf_save(&te, TDT_U64, &cgroup_id);
+
+  if (ctx->user_family == AF_INET) {
+    struct sockaddr_in in = {};
+    in.sin_family = AF_INET;
+    in.sin_port = ctx->user_port;
+    in.sin_addr.s_addr = ctx->user_ip4;
+
+    tdf_flex_save(&te, TDT_SOCKADDR, (unsigned long)&in, sizeof(in), KERNEL);
+  } else {
+    struct sockaddr_in6 in6 = {};
+    in6.sin6_family = AF_INET6;
+    in6.sin6_port = ctx->user_port;
+    in6.sin6_addr.in6_u.u6_addr32[0] = ctx->user_ip6[0];
+    in6.sin6_addr.in6_u.u6_addr32[1] = ctx->user_ip6[1];
+    in6.sin6_addr.in6_u.u6_addr32[2] = ctx->user_ip6[2];
+    in6.sin6_addr.in6_u.u6_addr32[3] = ctx->user_ip6[3];
+
+    tdf_flex_save(&te, TDT_SOCKADDR, (unsigned long)&in6, sizeof(in6), KERNEL);
+  }
+
+  int32_t type = ctx->type;
+  tdf_save(&te, TDT_S32, &type);
+
+  int32_t protocol = ctx->protocol;
+  tdf_save(&te, TDT_S32, &protocol);
+  /*====================== PARAMETERS ======================*/
+
+  tdf_submit_event(&te);
+  return 1;
+}
+
+SEC("cgroup/connect4")
+int tdf_cgroup_connect4(struct bpf_sock_addr *ctx) {
+  return cgroup_sock_addr_event(ctx, TDE_CGROUP_CONNECT, TDS_CGROUP_CONNECT);
+}
+
+SEC("cgroup/connect6")
+int tdf_cgroup_connect6(struct bpf_sock_addr *ctx) {
+  return cgroup_sock_addr_event(ctx, TDE_CGROUP_CONNECT, TDS_CGROUP_CONNECT);
+}
+
+// sendmsg programs only run for unconnected udp sockets
+SEC("cgroup/sendmsg4")
+int tdf_cgroup_sendmsg4(struct bpf_sock_addr *ctx) {
+  return cgroup_sock_addr_event(ctx, TDE_CGROUP_SENDMSG, TDS_CGROUP_SENDMSG);
+}
+
+SEC("cgroup/sendmsg6")
+int tdf_cgroup_sendmsg6(struct bpf_sock_addr *ctx) {
+  return cgroup_sock_addr_event(ctx, TDE_CGROUP_SENDMSG, TDS_CGROUP_SENDMSG);
+}
+
+SEC("cgroup/sock_create")
+int tdf_cgroup_sock_create(struct bpf_sock *sk) {
+  tarian_event_t te;
+  int resp = new_event(sk, TDE_CGROUP_SOCK_CREATE, &te, FIXED, TDS_CGROUP_SOCK_CREATE);
+  if (resp != TDC_SUCCESS) {
+    stats__add(resp);
+    return 1;
+  }
+
+  /*====================== PARAMETERS ======================*/
+  u64 cgroup_id = bpf_get_current_cgroup_id();
+  tdf_save(&te, TDT_U64, &cgroup_id);
+
+  int32_t family = sk->family;
+  tdf_save(&te, TDT_S32, &family);
+
+  int32_t type = sk->type;
+  tdf_save(&te, TDT_S32, &type);
+
+  int32_t protocol = sk->protocol;
+  tdf_save(&te, TDT_S32, &protocol);
+  /*====================== PARAMETERS ======================*/
+
+  tdf_submit_event(&te);
+  return 1;
+}
+
+// dispatches raw_syscalls:sys_enter to the entry program of the syscall, if it is captured. The
+// compat syscalls are skipped, their numbers index another table, e.g. ia32 read is x86_64 close.
+SEC("raw_tracepoint/sys_enter")
+int tdf_sys_enter(struct bpf_raw_tracepoint_args *ctx) {
+  if (in_compat_syscall())
+    return 0;
+
+  bpf_tail_call(ctx, &sys_enter_calls, (u32)ctx->args[1]);
+  return 0;
+}
+
+// dispatches raw_syscalls:sys_exit to the exit program of the syscall, if it is captured, skipping
+// the compat syscalls as tdf_sys_enter
+SEC("raw_tracepoint/sys_exit")
+int tdf_sys_exit(struct bpf_raw_tracepoint_args *ctx) {
+  if (in_compat_syscall())
+    return 0;
+
+  bpf_tail_call(ctx, &sys_exit_calls, get_syscall_id((struct pt_regs *)ctx->args[0]));
+  return 0;
+}
@@ -59,10 +59,23 @@ stain int init_event_meta_data_t(tarian_event_t *te, int event) {
     em->ts = bpf_ktime_get_ns();
     em->event = event;
     em->nparams = 0;
+#ifdef TDE_FIRST_HOOK
+    if (event >= TDE_FIRST_HOOK) {
+      em->syscall = -1;
+      em->processor = (uint16_t)bpf_get_smp_processor_id();
+      return init_task_meta_data_t(te);
+    }
+#endif
+
 #if LINUX_VERSION_CODE < KERNEL_VERSION(4, 17, 0)
     em->syscall = get_syscall_id(te->ctx);
 #else
-    struct pt_regs *regs = PT_REGS_SYSCALL_REGS(te->ctx);
+    struct pt_regs *regs;
+    if (tarian_tracepoint)
+      regs = (struct pt_regs *)((struct bpf_raw_tracepoint_args *)te->ctx)->args[0];
+    else
+      regs = PT_REGS_SYSCALL_REGS(te->ctx);
+
     em->syscall = get_syscall_id(regs);
 #endif
     em->processor = (uint16_t)bpf_get_smp_processor_id();
@@ -10,18 +10,83 @@ char LICENSE[] SEC("license") = "Dual MIT/GPL";
 #define KRETPROBE(__hook) SEC("kprobe/" #__hook)
 
 #if defined(bpf_target_x86)
+#define SYSCALL_PREFIX "__x64_sys_"
 #define __PT_PARM6_REG r9
 #define __PT_SYSCALL_ID orig_ax
 #elif defined(bpf_target_arm64)
+#define SYSCALL_PREFIX "__arm64_sys_"
 #define __PT_PARM6_REG regs[5]
 #define __PT_SYSCALL_ID syscallno
 #endif
 
+// hooks the syscall wrapper of the target architecture, e.g. __x64_sys_execve or __arm64_sys_execve
+#define SYSCALL_KPROBE(__syscall) SEC("kprobe/" SYSCALL_PREFIX #__syscall)
+#define SYSCALL_KRETPROBE(__syscall) SEC("kprobe/" SYSCALL_PREFIX #__syscall)
+
+// defines the entry programs of a syscall sharing the body following the macro, which receives the
+// context of the program and the registers of the syscall: tdf_<syscall>_e attached as kprobe on the
+// syscall wrapper and tdf_<syscall>_te tail called from raw_syscalls:sys_enter
+#define SYSCALL_ENTRY(__syscall)                                               \
+  stain int __tdf_##__syscall##_e(void *ctx, struct pt_regs *regs);           \
+  SYSCALL_KPROBE(__syscall)                                                   \
+  int BPF_KPROBE(tdf_##__syscall##_e, struct pt_regs *regs) {                 \
+    return __tdf_##__syscall##_e(ctx, regs);                                  \
+  }                                                                           \
+  SEC("raw_tracepoint/sys_enter")                                             \
+  int tdf_##__syscall##_te(struct bpf_raw_tracepoint_args *ctx) {             \
+    return __tdf_##__syscall##_e(ctx, (struct pt_regs *)ctx->args[0]);        \
+  }                                                                           \
+  stain int __tdf_##__syscall##_e(void *ctx, struct pt_regs *regs)
+
+// defines the exit programs of a syscall sharing the body following the macro, which receives the
+// context of the program and the return value of the syscall as __type: tdf_<syscall>_r attached as
+// kretprobe on the syscall wrapper and tdf_<syscall>_tr tail called from raw_syscalls:sys_exit
+#define SYSCALL_EXIT(__syscall, __type)                                        \
+  stain int __tdf_##__syscall##_r(void *ctx, __type ret);                     \
+  SYSCALL_KRETPROBE(__syscall)                                                \
+  int BPF_KRETPROBE(tdf_##__syscall##_r, __type ret) {                        \
+    return __tdf_##__syscall##_r(ctx, ret);                                   \
+  }                                                                           \
+  SEC("raw_tracepoint/sys_exit")                                              \
+  int tdf_##__syscall##_tr(struct bpf_raw_tracepoint_args *ctx) {             \
+    return __tdf_##__syscall##_r(ctx, (__type)ctx->args[1]);                  \
+  }                                                                           \
+  stain int __tdf_##__syscall##_r(void *ctx, __type ret)
+
+// clone swaps the tls and child_tidptr arguments on architectures with CONFIG_CLONE_BACKWARDS
+#if defined(bpf_target_arm64)
+#define CLONE_CHILD_TID_IDX 4
+#define CLONE_TLS_IDX 3
+#else
+#define CLONE_CHILD_TID_IDX 3
+#define CLONE_TLS_IDX 4
+#endif
+
 #define PT_REGS_PARM6_CORE(x) BPF_CORE_READ(__PT_REGS_CAST(x), __PT_PARM6_REG)
 #define PT_REGS_PARM6_CORE_SYSCALL(x) PT_REGS_PARM6_CORE(x)
 #define PT_REGS_SYSCALL_CORE(x)                                                \
   BPF_CORE_READ(__PT_REGS_CAST(x), __PT_SYSCALL_ID)
 
+// flags of the tasks in a 32-bit syscall on a 64-bit kernel, whose numbers and registers follow the compat ABI
+#if defined(bpf_target_x86)
+#define TS_COMPAT 0x0002 /* thread_info.status, set by int 0x80 and the ia32 entries */
+#elif defined(bpf_target_arm64)
+#define TIF_32BIT 22 /* bit of thread_info.flags, set for AArch32 tasks */
+#endif
+
+// reports whether the current task runs a compat syscall, as in_compat_syscall() of the kernel
+stain bool in_compat_syscall() {
+  struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+#if defined(bpf_target_x86)
+  return (BPF_CORE_READ(task, thread_info.status) & TS_COMPAT) != 0;
+#elif defined(bpf_target_arm64)
+  return (BPF_CORE_READ(task, thread_info.flags) & (1UL << TIF_32BIT)) != 0;
+#else
+  return false;
+#endif
+}
+
 stain uint32_t get_syscall_id(struct pt_regs *regs) {
   return (uint32_t)PT_REGS_SYSCALL_CORE(regs);
 };
@@ -95,8 +160,7 @@ stain void print_event(tarian_event_t *te) {
 };
 
 #define SCRATCH_SAFE_ACCESS(x) (x) & (MAX_STRING_SIZE - 1)
-stain uint8_t *get__cwd_d_path(uint32_t *slen, scratch_space_t *s, struct task_struct *task) {
-  struct path path = BPF_CORE_READ(task, fs, pwd);
+stain uint8_t *get__d_path(uint32_t *slen, scratch_space_t *s, struct path path) {
   struct dentry *dentry = path.dentry;
   struct vfsmount *vfsmnt = path.mnt;
 
@@ -171,4 +235,9 @@ stain uint8_t *get__cwd_d_path(uint32_t *slen, scratch_space_t *s, struct task_s
   return &(s->data[SCRATCH_SAFE_ACCESS(max_buf_len)]);
 }
 
+// task->fs->pwd
+stain uint8_t *get__cwd_d_path(uint32_t *slen, scratch_space_t *s, struct task_struct *task) {
+  return get__d_path(slen, s, BPF_CORE_READ(task, fs, pwd));
+}
+
 #endif
\ No newline at end of file
@@ -15,13 +15,40 @@
 #define LINUX_VERSION_CODE KERNEL_VERSION(LINUX_VERSION_MAJOR, LINUX_VERSION_MINOR, LINUX_VERSION_PATCH)
 #endif
 
+#define AF_UNSPEC 0
 #define AF_UNIX 1
 #define AF_INET 2
 #define AF_INET6 10
 
 #define EVENT_RINGBUF_MAP_NAME events
-#define RINGBUF_MAX_ENTRIES 1024 * 1024 * 128 /* 128MB */
+#define RINGBUF_MAX_ENTRIES 1024 * 1024 * 8 /* 8MB per ring buffer, 16 ring buffers */
 #define ARRAY_OF_MAPS_MAX_ENTRIES 16
+#define MAX_SYSCALL_NR 512 /* above the highest syscall number of the supported architectures */
+#define MAX_TLS_DATA_SIZE 4096 /* plaintext bytes captured per TLS read or write */
+#define MAX_TLS_BUFFERS 10240
+#define MAX_POLICY_RULES 4096
+#define MAX_EXEC_MEM_CALLS 10240
+#define MAX_SOCKET_DATA_SIZE 256 /* payload bytes captured per socket message */
+#define MAX_RECV_CALLS 10240
+#define MAX_SOCK_OWNERS 10240
+
+#define PROT_WRITE 0x2
+#define PROT_EXEC 0x4
+#define MAP_ANONYMOUS 0x20
+
+#define VM_WRITE 0x00000002
+#define VM_EXEC 0x00000004
+
+#define SIGCHLD 17
+#define CLONE_VM 0x00000100
+#define CLONE_VFORK 0x00004000
+#define CLONE_ARGS_SIZE_VER0 64 /* size of the first version of struct clone_args */
+
+/* actions of the policy rules */
+#define TARIAN_ACTION_DENY 1
+#define TARIAN_ACTION_AUDIT 2
+
+#define EPERM 1
 
 #define stain static __always_inline
 
@@ -48,123 +75,8 @@ enum tarian_param_type_e{
     TDT_IOVEC_ARR,
     TDT_SOCKADDR,
 };  
-typedef enum tarian_events_e{
-    // execve
-    TDE_SYSCALL_EXECVE_E = 2,
-    TDE_SYSCALL_EXECVE_R,
-
-    // execveat
-    TDE_SYSCALL_EXECVEAT_E,
-    TDE_SYSCALL_EXECVEAT_R,
-    
-    // clone
-    TDE_SYSCALL_CLONE_E,
-    TDE_SYSCALL_CLONE_R,
-
-    // close
-    TDE_SYSCALL_CLOSE_E,
-    TDE_SYSCALL_CLOSE_R,
-
-    // read
-    TDE_SYSCALL_READ_E,
-    TDE_SYSCALL_READ_R,
-
-    // write
-    TDE_SYSCALL_WRITE_E,
-    TDE_SYSCALL_WRITE_R,
-
-    // open
-    TDE_SYSCALL_OPEN_E,
-    TDE_SYSCALL_OPEN_R,
-
-    // readv
-    TDE_SYSCALL_READV_E,
-    TDE_SYSCALL_READV_R,
-
-    // writev
-    TDE_SYSCALL_WRITEV_E,
-    TDE_SYSCALL_WRITEV_R,
-
-    // openat
-    TDE_SYSCALL_OPENAT_E,
-    TDE_SYSCALL_OPENAT_R,
-
-    // openat2
-    TDE_SYSCALL_OPENAT2_E,
-    TDE_SYSCALL_OPENAT2_R,
-    
-    // listen
-    TDE_SYSCALL_LISTEN_E,
-    TDE_SYSCALL_LISTEN_R,
-
-    // socket
-    TDE_SYSCALL_SOCKET_E,
-    TDE_SYSCALL_SOCKET_R,
-
-    // accept
-    TDE_SYSCALL_ACCEPT_E,
-    TDE_SYSCALL_ACCEPT_R,
-
-    // bind
-    TDE_SYSCALL_BIND_E,
-    TDE_SYSCALL_BIND_R,
-
-    // connect
-    TDE_SYSCALL_CONNECT_E,
-    TDE_SYSCALL_CONNECT_R,
-} tarian_event_code;
-
-/*****Event Data Size - START****/
+/* event codes and sizes (TDS_*) are generated from tarian/events.json into events.h */
 #define MD_SIZE sizeof(tarian_meta_data_t) /* sizeof tarian meta data for each event*/
 #define PARAM_SIZE sizeof(uint16_t)
 
-#define TDS_EXECVE_E (MD_SIZE + MAX_STRING_SIZE*2 + PARAM_SIZE*2)
-#define TDS_EXECVE_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_EXECVEAT_E (MD_SIZE + sizeof(int32_t)*2 + MAX_STRING_SIZE*2 + PARAM_SIZE*2)
-#define TDS_EXECVEAT_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_CLONE_E (MD_SIZE + sizeof(uint64_t)*3 + sizeof(int32_t)*2)
-#define TDS_CLONE_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_CLOSE_E (MD_SIZE + sizeof(int32_t))
-#define TDS_CLOSE_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_READ_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
-#define TDS_READ_R (MD_SIZE + sizeof(long))
-
-#define TDS_WRITE_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
-#define TDS_WRITE_R (MD_SIZE + sizeof(long))
-
-#define TDS_OPEN_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(int32_t) + sizeof(uint32_t))
-#define TDS_OPEN_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_READV_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
-#define TDS_READV_R (MD_SIZE + sizeof(long))
-
-#define TDS_WRITEV_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
-#define TDS_WRITEV_R (MD_SIZE + sizeof(long))
-
-#define TDS_OPENAT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
-#define TDS_OPENAT_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_OPENAT2_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint64_t) * 3)
-#define TDS_OPENAT2_R (MD_SIZE + sizeof(long))
-
-#define TDS_LISTEN_E (MD_SIZE + sizeof(int32_t) * 2)
-#define TDS_LISTEN_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_SOCKET_E (MD_SIZE + sizeof(int32_t) * 3)
-#define TDS_SOCKET_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_ACCEPT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
-#define TDS_ACCEPT_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_BIND_E (MD_SIZE + sizeof(int32_t) * 2 +  MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
-#define TDS_BIND_R (MD_SIZE + sizeof(int32_t))
-
-#define TDS_CONNECT_E (MD_SIZE + sizeof(int32_t) * 2 +  MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
-#define TDS_CONNECT_R (MD_SIZE + sizeof(int32_t))
-/*****Event Data Size - END*****/
-
 #endif
\ No newline at end of file
@@ -0,0 +1,503 @@
+// Code generated by eventgen from events.json; DO NOT EDIT.
+
+#ifndef __UTLIS_SHARED_EVENTS_H__
+#define __UTLIS_SHARED_EVENTS_H__
+
+typedef enum tarian_events_e{
+    // execve
+    TDE_SYSCALL_EXECVE_E = 2,
+    TDE_SYSCALL_EXECVE_R,
+
+    // execveat
+    TDE_SYSCALL_EXECVEAT_E,
+    TDE_SYSCALL_EXECVEAT_R,
+
+    // clone
+    TDE_SYSCALL_CLONE_E,
+    TDE_SYSCALL_CLONE_R,
+
+    // close
+    TDE_SYSCALL_CLOSE_E,
+    TDE_SYSCALL_CLOSE_R,
+
+    // read
+    TDE_SYSCALL_READ_E,
+    TDE_SYSCALL_READ_R,
+
+    // write
+    TDE_SYSCALL_WRITE_E,
+    TDE_SYSCALL_WRITE_R,
+
+    // open
+    TDE_SYSCALL_OPEN_E,
+    TDE_SYSCALL_OPEN_R,
+
+    // readv
+    TDE_SYSCALL_READV_E,
+    TDE_SYSCALL_READV_R,
+
+    // writev
+    TDE_SYSCALL_WRITEV_E,
+    TDE_SYSCALL_WRITEV_R,
+
+    // openat
+    TDE_SYSCALL_OPENAT_E,
+    TDE_SYSCALL_OPENAT_R,
+
+    // openat2
+    TDE_SYSCALL_OPENAT2_E,
+    TDE_SYSCALL_OPENAT2_R,
+
+    // listen
+    TDE_SYSCALL_LISTEN_E,
+    TDE_SYSCALL_LISTEN_R,
+
+    // socket
+    TDE_SYSCALL_SOCKET_E,
+    TDE_SYSCALL_SOCKET_R,
+
+    // accept
+    TDE_SYSCALL_ACCEPT_E,
+    TDE_SYSCALL_ACCEPT_R,
+
+    // bind
+    TDE_SYSCALL_BIND_E,
+    TDE_SYSCALL_BIND_R,
+
+    // connect
+    TDE_SYSCALL_CONNECT_E,
+    TDE_SYSCALL_CONNECT_R,
+
+    // unlink
+    TDE_SYSCALL_UNLINK_E,
+    TDE_SYSCALL_UNLINK_R,
+
+    // unlinkat
+    TDE_SYSCALL_UNLINKAT_E,
+    TDE_SYSCALL_UNLINKAT_R,
+
+    // rename
+    TDE_SYSCALL_RENAME_E,
+    TDE_SYSCALL_RENAME_R,
+
+    // renameat2
+    TDE_SYSCALL_RENAMEAT2_E,
+    TDE_SYSCALL_RENAMEAT2_R,
+
+    // chmod
+    TDE_SYSCALL_CHMOD_E,
+    TDE_SYSCALL_CHMOD_R,
+
+    // fchmodat
+    TDE_SYSCALL_FCHMODAT_E,
+    TDE_SYSCALL_FCHMODAT_R,
+
+    // chown
+    TDE_SYSCALL_CHOWN_E,
+    TDE_SYSCALL_CHOWN_R,
+
+    // fchownat
+    TDE_SYSCALL_FCHOWNAT_E,
+    TDE_SYSCALL_FCHOWNAT_R,
+
+    // truncate
+    TDE_SYSCALL_TRUNCATE_E,
+    TDE_SYSCALL_TRUNCATE_R,
+
+    // ftruncate
+    TDE_SYSCALL_FTRUNCATE_E,
+    TDE_SYSCALL_FTRUNCATE_R,
+
+    // link
+    TDE_SYSCALL_LINK_E,
+    TDE_SYSCALL_LINK_R,
+
+    // linkat
+    TDE_SYSCALL_LINKAT_E,
+    TDE_SYSCALL_LINKAT_R,
+
+    // symlink
+    TDE_SYSCALL_SYMLINK_E,
+    TDE_SYSCALL_SYMLINK_R,
+
+    // symlinkat
+    TDE_SYSCALL_SYMLINKAT_E,
+    TDE_SYSCALL_SYMLINKAT_R,
+
+    // setuid
+    TDE_SYSCALL_SETUID_E,
+    TDE_SYSCALL_SETUID_R,
+
+    // setgid
+    TDE_SYSCALL_SETGID_E,
+    TDE_SYSCALL_SETGID_R,
+
+    // setreuid
+    TDE_SYSCALL_SETREUID_E,
+    TDE_SYSCALL_SETREUID_R,
+
+    // setresuid
+    TDE_SYSCALL_SETRESUID_E,
+    TDE_SYSCALL_SETRESUID_R,
+
+    // setresgid
+    TDE_SYSCALL_SETRESGID_E,
+    TDE_SYSCALL_SETRESGID_R,
+
+    // setfsuid
+    TDE_SYSCALL_SETFSUID_E,
+    TDE_SYSCALL_SETFSUID_R,
+
+    // capset
+    TDE_SYSCALL_CAPSET_E,
+    TDE_SYSCALL_CAPSET_R,
+
+    // setns
+    TDE_SYSCALL_SETNS_E,
+    TDE_SYSCALL_SETNS_R,
+
+    // unshare
+    TDE_SYSCALL_UNSHARE_E,
+    TDE_SYSCALL_UNSHARE_R,
+
+    // mount
+    TDE_SYSCALL_MOUNT_E,
+    TDE_SYSCALL_MOUNT_R,
+
+    // umount
+    TDE_SYSCALL_UMOUNT_E,
+    TDE_SYSCALL_UMOUNT_R,
+
+    // pivot_root
+    TDE_SYSCALL_PIVOT_ROOT_E,
+    TDE_SYSCALL_PIVOT_ROOT_R,
+
+    // chroot
+    TDE_SYSCALL_CHROOT_E,
+    TDE_SYSCALL_CHROOT_R,
+
+    // init_module
+    TDE_SYSCALL_INIT_MODULE_E,
+    TDE_SYSCALL_INIT_MODULE_R,
+
+    // finit_module
+    TDE_SYSCALL_FINIT_MODULE_E,
+    TDE_SYSCALL_FINIT_MODULE_R,
+
+    // delete_module
+    TDE_SYSCALL_DELETE_MODULE_E,
+    TDE_SYSCALL_DELETE_MODULE_R,
+
+    // bpf
+    TDE_SYSCALL_BPF_E,
+    TDE_SYSCALL_BPF_R,
+
+    // ptrace
+    TDE_SYSCALL_PTRACE_E,
+    TDE_SYSCALL_PTRACE_R,
+
+    // process_vm_readv
+    TDE_SYSCALL_PROCESS_VM_READV_E,
+    TDE_SYSCALL_PROCESS_VM_READV_R,
+
+    // process_vm_writev
+    TDE_SYSCALL_PROCESS_VM_WRITEV_E,
+    TDE_SYSCALL_PROCESS_VM_WRITEV_R,
+
+    // memfd_create
+    TDE_SYSCALL_MEMFD_CREATE_E,
+    TDE_SYSCALL_MEMFD_CREATE_R,
+
+    // mmap
+    TDE_SYSCALL_MMAP_E,
+    TDE_SYSCALL_MMAP_R,
+
+    // mprotect
+    TDE_SYSCALL_MPROTECT_E,
+    TDE_SYSCALL_MPROTECT_R,
+
+    // clone3
+    TDE_SYSCALL_CLONE3_E,
+    TDE_SYSCALL_CLONE3_R,
+
+    // fork
+    TDE_SYSCALL_FORK_E,
+    TDE_SYSCALL_FORK_R,
+
+    // vfork
+    TDE_SYSCALL_VFORK_E,
+    TDE_SYSCALL_VFORK_R,
+
+    // sendto
+    TDE_SYSCALL_SENDTO_E,
+    TDE_SYSCALL_SENDTO_R,
+
+    // recvfrom
+    TDE_SYSCALL_RECVFROM_E,
+    TDE_SYSCALL_RECVFROM_R,
+
+    // sendmsg
+    TDE_SYSCALL_SENDMSG_E,
+    TDE_SYSCALL_SENDMSG_R,
+
+    // recvmsg
+    TDE_SYSCALL_RECVMSG_E,
+    TDE_SYSCALL_RECVMSG_R,
+
+    // commit_creds
+    TDE_COMMIT_CREDS,
+
+    // do_init_module
+    TDE_DO_INIT_MODULE,
+
+    // tls_write
+    TDE_TLS_WRITE,
+
+    // tls_read
+    TDE_TLS_READ,
+
+    // bprm_check_security
+    TDE_BPRM_CHECK_SECURITY,
+
+    // file_open
+    TDE_FILE_OPEN,
+
+    // socket_connect
+    TDE_SOCKET_CONNECT,
+
+    // cgroup_connect
+    TDE_CGROUP_CONNECT,
+
+    // cgroup_sendmsg
+    TDE_CGROUP_SENDMSG,
+
+    // cgroup_sock_create
+    TDE_CGROUP_SOCK_CREATE,
+
+    // connection_connect
+    TDE_CONNECTION_CONNECT,
+
+    // connection_accept
+    TDE_CONNECTION_ACCEPT,
+
+    // connection_state
+    TDE_CONNECTION_STATE,
+
+    // connection_close
+    TDE_CONNECTION_CLOSE,
+} tarian_event_code;
+
+// events coded from TDE_FIRST_HOOK on are not raised by syscalls
+#define TDE_FIRST_HOOK TDE_COMMIT_CREDS
+
+/*****Event Data Size - START****/
+#define TDS_EXECVE_E (MD_SIZE + MAX_STRING_SIZE*2 + PARAM_SIZE*2)
+#define TDS_EXECVE_R (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE)
+
+#define TDS_EXECVEAT_E (MD_SIZE + sizeof(int32_t)*2 + MAX_STRING_SIZE*2 + PARAM_SIZE*2)
+#define TDS_EXECVEAT_R (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE)
+
+#define TDS_CLONE_E (MD_SIZE + sizeof(uint64_t)*3 + sizeof(int32_t)*2)
+#define TDS_CLONE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CLOSE_E (MD_SIZE + sizeof(int32_t))
+#define TDS_CLOSE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_READ_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_READ_R (MD_SIZE + sizeof(long))
+
+#define TDS_WRITE_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_WRITE_R (MD_SIZE + sizeof(long))
+
+#define TDS_OPEN_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(int32_t) + sizeof(uint32_t))
+#define TDS_OPEN_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_READV_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_READV_R (MD_SIZE + sizeof(long))
+
+#define TDS_WRITEV_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_WRITEV_R (MD_SIZE + sizeof(long))
+
+#define TDS_OPENAT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_OPENAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_OPENAT2_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint64_t) * 3)
+#define TDS_OPENAT2_R (MD_SIZE + sizeof(long))
+
+#define TDS_LISTEN_E (MD_SIZE + sizeof(int32_t) * 2)
+#define TDS_LISTEN_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_SOCKET_E (MD_SIZE + sizeof(int32_t) * 3)
+#define TDS_SOCKET_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_ACCEPT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
+#define TDS_ACCEPT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_BIND_E (MD_SIZE + sizeof(int32_t) * 2 +  MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
+#define TDS_BIND_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CONNECT_E (MD_SIZE + sizeof(int32_t) * 2 +  MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
+#define TDS_CONNECT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_UNLINK_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_UNLINK_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_UNLINKAT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_UNLINKAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_RENAME_E (MD_SIZE + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_RENAME_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_RENAMEAT2_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2 + sizeof(uint32_t))
+#define TDS_RENAMEAT2_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CHMOD_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_CHMOD_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_FCHMODAT_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_FCHMODAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CHOWN_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t) * 2)
+#define TDS_CHOWN_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_FCHOWNAT_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t) * 2)
+#define TDS_FCHOWNAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_TRUNCATE_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(int64_t))
+#define TDS_TRUNCATE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_FTRUNCATE_E (MD_SIZE + sizeof(int32_t) + sizeof(int64_t))
+#define TDS_FTRUNCATE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_LINK_E (MD_SIZE + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_LINK_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_LINKAT_E (MD_SIZE + sizeof(int32_t) * 3 + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_LINKAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_SYMLINK_E (MD_SIZE + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_SYMLINK_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_SYMLINKAT_E (MD_SIZE + sizeof(int32_t) + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_SYMLINKAT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_SETUID_E (MD_SIZE + sizeof(uint32_t) * 5)
+#define TDS_SETUID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_SETGID_E (MD_SIZE + sizeof(uint32_t) * 5)
+#define TDS_SETGID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_SETREUID_E (MD_SIZE + sizeof(uint32_t) * 6)
+#define TDS_SETREUID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_SETRESUID_E (MD_SIZE + sizeof(uint32_t) * 7)
+#define TDS_SETRESUID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_SETRESGID_E (MD_SIZE + sizeof(uint32_t) * 7)
+#define TDS_SETRESGID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_SETFSUID_E (MD_SIZE + sizeof(uint32_t) * 5)
+#define TDS_SETFSUID_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 4)
+
+#define TDS_CAPSET_E (MD_SIZE + sizeof(int32_t) + sizeof(uint64_t) * 3)
+#define TDS_CAPSET_R (MD_SIZE + sizeof(int32_t) + sizeof(uint64_t) * 3)
+
+#define TDS_SETNS_E (MD_SIZE + sizeof(int32_t) * 2 + sizeof(uint32_t) * 7)
+#define TDS_SETNS_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 7)
+
+#define TDS_UNSHARE_E (MD_SIZE + sizeof(uint64_t) + sizeof(uint32_t) * 7)
+#define TDS_UNSHARE_R (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 7)
+
+#define TDS_MOUNT_E (MD_SIZE + MAX_STRING_SIZE * 3 + PARAM_SIZE * 3 + sizeof(uint64_t))
+#define TDS_MOUNT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_UMOUNT_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(int32_t))
+#define TDS_UMOUNT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_PIVOT_ROOT_E (MD_SIZE + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_PIVOT_ROOT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CHROOT_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_CHROOT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_INIT_MODULE_E (MD_SIZE + sizeof(uint64_t) + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_INIT_MODULE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_FINIT_MODULE_E (MD_SIZE + sizeof(int32_t) * 2 + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+#define TDS_FINIT_MODULE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_DELETE_MODULE_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_DELETE_MODULE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_BPF_E (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) * 2 + MAX_STRING_SIZE + PARAM_SIZE)
+#define TDS_BPF_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_PTRACE_E (MD_SIZE + sizeof(int64_t) + sizeof(int32_t) + sizeof(uint64_t) * 2)
+#define TDS_PTRACE_R (MD_SIZE + sizeof(long))
+
+#define TDS_PROCESS_VM_READV_E (MD_SIZE + sizeof(int32_t) + sizeof(uint64_t) * 4)
+#define TDS_PROCESS_VM_READV_R (MD_SIZE + sizeof(long))
+
+#define TDS_PROCESS_VM_WRITEV_E (MD_SIZE + sizeof(int32_t) + sizeof(uint64_t) * 4)
+#define TDS_PROCESS_VM_WRITEV_R (MD_SIZE + sizeof(long))
+
+#define TDS_MEMFD_CREATE_E (MD_SIZE + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t))
+#define TDS_MEMFD_CREATE_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_MMAP_E (MD_SIZE + sizeof(uint64_t) * 3 + sizeof(int32_t) * 3)
+#define TDS_MMAP_R (MD_SIZE + sizeof(long))
+
+#define TDS_MPROTECT_E (MD_SIZE + sizeof(uint64_t) * 2 + sizeof(int32_t))
+#define TDS_MPROTECT_R (MD_SIZE + sizeof(int32_t))
+
+#define TDS_CLONE3_E (MD_SIZE + sizeof(uint64_t) * 8)
+#define TDS_CLONE3_R (MD_SIZE + sizeof(long))
+
+#define TDS_FORK_E (MD_SIZE + sizeof(uint64_t))
+#define TDS_FORK_R (MD_SIZE + sizeof(long))
+
+#define TDS_VFORK_E (MD_SIZE + sizeof(uint64_t))
+#define TDS_VFORK_R (MD_SIZE + sizeof(long))
+
+#define TDS_SENDTO_E (MD_SIZE + sizeof(int32_t) * 2 + sizeof(uint64_t) + sizeof(uint32_t) + MAX_UNIX_SOCKET_PATH + MAX_SOCKET_DATA_SIZE + PARAM_SIZE * 2)
+#define TDS_SENDTO_R (MD_SIZE + sizeof(long))
+
+#define TDS_RECVFROM_E (MD_SIZE + sizeof(int32_t) + sizeof(uint64_t) + sizeof(uint32_t))
+#define TDS_RECVFROM_R (MD_SIZE + sizeof(long) + MAX_UNIX_SOCKET_PATH + MAX_SOCKET_DATA_SIZE + PARAM_SIZE * 2)
+
+#define TDS_SENDMSG_E (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t) + sizeof(uint64_t) + MAX_UNIX_SOCKET_PATH + MAX_SOCKET_DATA_SIZE + PARAM_SIZE * 2)
+#define TDS_SENDMSG_R (MD_SIZE + sizeof(long))
+
+#define TDS_RECVMSG_E (MD_SIZE + sizeof(int32_t) + sizeof(uint32_t))
+#define TDS_RECVMSG_R (MD_SIZE + sizeof(long) + MAX_UNIX_SOCKET_PATH + MAX_SOCKET_DATA_SIZE + PARAM_SIZE * 2)
+
+#define TDS_COMMIT_CREDS (MD_SIZE + sizeof(uint64_t) * 2)
+
+#define TDS_DO_INIT_MODULE (MD_SIZE + MAX_STRING_SIZE * 2 + PARAM_SIZE * 2)
+
+#define TDS_TLS_WRITE (MD_SIZE + sizeof(uint8_t) + sizeof(int32_t) + PARAM_SIZE + MAX_TLS_DATA_SIZE)
+
+#define TDS_TLS_READ (MD_SIZE + sizeof(uint8_t) + sizeof(int32_t) + PARAM_SIZE + MAX_TLS_DATA_SIZE)
+
+#define TDS_BPRM_CHECK_SECURITY (MD_SIZE + sizeof(uint8_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(uint32_t) + sizeof(uint64_t))
+
+#define TDS_FILE_OPEN (MD_SIZE + sizeof(uint8_t) + MAX_STRING_SIZE + PARAM_SIZE + sizeof(int32_t) + sizeof(uint32_t) + sizeof(uint64_t))
+
+#define TDS_SOCKET_CONNECT (MD_SIZE + sizeof(uint8_t) + MAX_UNIX_SOCKET_PATH + PARAM_SIZE)
+
+#define TDS_CGROUP_CONNECT (MD_SIZE + sizeof(uint64_t) + MAX_UNIX_SOCKET_PATH + PARAM_SIZE + sizeof(int32_t) * 2)
+
+#define TDS_CGROUP_SENDMSG (MD_SIZE + sizeof(uint64_t) + MAX_UNIX_SOCKET_PATH + PARAM_SIZE + sizeof(int32_t) * 2)
+
+#define TDS_CGROUP_SOCK_CREATE (MD_SIZE + sizeof(uint64_t) + sizeof(int32_t) * 3)
+
+#define TDS_CONNECTION_CONNECT (MD_SIZE + (MAX_UNIX_SOCKET_PATH + PARAM_SIZE) * 2)
+
+#define TDS_CONNECTION_ACCEPT (MD_SIZE + (MAX_UNIX_SOCKET_PATH + PARAM_SIZE) * 2)
+
+#define TDS_CONNECTION_STATE (MD_SIZE + (MAX_UNIX_SOCKET_PATH + PARAM_SIZE) * 2 + sizeof(int32_t) * 2)
+
+#define TDS_CONNECTION_CLOSE (MD_SIZE + (MAX_UNIX_SOCKET_PATH + PARAM_SIZE) * 2 + sizeof(int32_t) + sizeof(uint64_t) * 2)
+/*****Event Data Size - END*****/
+
+#endif
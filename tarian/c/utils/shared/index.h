@@ -2,6 +2,7 @@
 #define __UTLIS_SHARED_INDEX_H__
 
 #include "constants.h"
+#include "events.h"
 #include "types.h"
 #include "codes.h"
 
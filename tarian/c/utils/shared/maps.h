@@ -85,128 +85,240 @@ stain void *map__allocate_space(void *map) {
   return get__current_cpu_buf(map);
 };
 
-#if LINUX_VERSION_CODE >= KERNEL_VERSION(5, 8, 0) && false
-  /*
-  *
-  * RINGBUF
-  * This map is used for sending events to
-  * userspace
-  *
-  */
-  struct ringbuf {
-    __uint(type, BPF_MAP_TYPE_RINGBUF);
-    __uint(max_entries, RINGBUF_MAX_ENTRIES);
-  };
-
-  #define BPF_RINGBUF(_map_name) struct ringbuf _map_name SEC(".maps");
-
-  BPF_RINGBUF(erb_cpu0);
-  BPF_RINGBUF(erb_cpu1);
-  BPF_RINGBUF(erb_cpu2);
-  BPF_RINGBUF(erb_cpu3);
-  BPF_RINGBUF(erb_cpu4);
-  BPF_RINGBUF(erb_cpu5);
-  BPF_RINGBUF(erb_cpu6);
-  BPF_RINGBUF(erb_cpu7);
-  BPF_RINGBUF(erb_cpu8);
-  BPF_RINGBUF(erb_cpu9);
-  BPF_RINGBUF(erb_cpu10);
-  BPF_RINGBUF(erb_cpu11);
-  BPF_RINGBUF(erb_cpu12);
-  BPF_RINGBUF(erb_cpu13);
-  BPF_RINGBUF(erb_cpu14);
-  BPF_RINGBUF(erb_cpu15);
-
-  struct {
-    __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
-    __uint(max_entries, ARRAY_OF_MAPS_MAX_ENTRIES);
-    __type(key, u32);
-    __array(values, struct ringbuf);
-  } events SEC(".maps") = {.values = {
-                              &erb_cpu0,
-                              &erb_cpu1,
-                              &erb_cpu2,
-                              &erb_cpu3,
-                              &erb_cpu4,
-                              &erb_cpu5,
-                              &erb_cpu6,
-                              &erb_cpu7,
-                              &erb_cpu8,
-                              &erb_cpu9,
-                              &erb_cpu10,
-                              &erb_cpu11,
-                              &erb_cpu12,
-                              &erb_cpu13,
-                              &erb_cpu14,
-                              &erb_cpu15,
-                          }};
-
-  stain struct ringbuffer *get_cpu_ringbuffer(void *map) {
-    uint32_t cpu_id = (uint32_t)bpf_get_smp_processor_id();
-	  return (struct ringbuffer *)bpf_map_lookup_elem(map, &cpu_id);
-  }
-
-  stain void *map__reserve_space(void *map, u64 size) {
-    struct ringbuffer *rbuf = get_cpu_ringbuffer(map);
-    if (!rbuf) return NULL;
-
-    return bpf_ringbuf_reserve(rbuf, size, 0);
-  };
-
-  stain int map__reserve_submit(void *data) {
-    if (!data) return TDCE_NULL_POINTER;
-    
-    bpf_ringbuf_submit(data, 0);
-
-    return TDC_SUCCESS;
-  };
-
-  stain int map__submit(void *map, void *data, u64 size) {
-    if (!map || !data) return TDCE_NULL_POINTER;
-    
-    if (bpf_ringbuf_output(map, data, size, 0) != 0) return TDCE_MAP_SUBMIT;
-
-    return TDC_SUCCESS;
-  }
-
-  stain int map__pringbuf_submit(void *map, void *data, u64 size) {
-    if (!data) return TDCE_NULL_POINTER;
-    
-    struct ringbuffer *rbuf = get_cpu_ringbuffer(map);
-    if (!rbuf) return TDCE_MAP_SUBMIT;
-
-    return map__submit(rbuf, data, size);
-  }
-
-  stain int map__discard(void *data) {
-    if (!data) return TDCE_NULL_POINTER;
-    
-    bpf_ringbuf_discard(data, 0);
-
-    return TDC_SUCCESS;
-  };
-
-#else
-  /*
-  * 
-  * PERF_EVENT_ARRAY
-  * This map is used as an fallback map 
-  * on kernel version which do not support ringbuf
-  * 
-  */
-  struct {
-    __uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
-    __uint(key_size, sizeof(int));
-    __uint(value_size, sizeof(u32));
-  } events SEC(".maps");
-
-  stain int map__submit(void *ctx, void *map, void *data, u64 size) {
-    if (!map || !data) return TDCE_NULL_POINTER;
-
-    if (bpf_perf_event_output(ctx, map, BPF_F_CURRENT_CPU, data, size) != 0) return TDCE_MAP_SUBMIT;
-
-    return TDC_SUCCESS;
-  };
-#endif
+/*
+*
+* Transport of the events, rewritten by userspace before loading:
+* 0 - perf event array, 1 - ring buffers (kernel >= 5.8).
+* The verifier prunes the branches of the unused transport.
+*
+*/
+const volatile u8 tarian_ringbuf = 0;
+
+/*
+*
+* RINGBUF
+* This map is used for sending events to
+* userspace when the kernel supports ring buffers
+*
+*/
+struct ringbuf {
+  __uint(type, BPF_MAP_TYPE_RINGBUF);
+  __uint(max_entries, RINGBUF_MAX_ENTRIES);
+};
+
+#define BPF_RINGBUF(_map_name) struct ringbuf _map_name SEC(".maps");
+
+BPF_RINGBUF(erb_cpu0);
+BPF_RINGBUF(erb_cpu1);
+BPF_RINGBUF(erb_cpu2);
+BPF_RINGBUF(erb_cpu3);
+BPF_RINGBUF(erb_cpu4);
+BPF_RINGBUF(erb_cpu5);
+BPF_RINGBUF(erb_cpu6);
+BPF_RINGBUF(erb_cpu7);
+BPF_RINGBUF(erb_cpu8);
+BPF_RINGBUF(erb_cpu9);
+BPF_RINGBUF(erb_cpu10);
+BPF_RINGBUF(erb_cpu11);
+BPF_RINGBUF(erb_cpu12);
+BPF_RINGBUF(erb_cpu13);
+BPF_RINGBUF(erb_cpu14);
+BPF_RINGBUF(erb_cpu15);
+
+struct {
+  __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
+  __uint(max_entries, ARRAY_OF_MAPS_MAX_ENTRIES);
+  __type(key, u32);
+  __array(values, struct ringbuf);
+} events_ringbuf SEC(".maps") = {.values = {
+                                    &erb_cpu0,
+                                    &erb_cpu1,
+                                    &erb_cpu2,
+                                    &erb_cpu3,
+                                    &erb_cpu4,
+                                    &erb_cpu5,
+                                    &erb_cpu6,
+                                    &erb_cpu7,
+                                    &erb_cpu8,
+                                    &erb_cpu9,
+                                    &erb_cpu10,
+                                    &erb_cpu11,
+                                    &erb_cpu12,
+                                    &erb_cpu13,
+                                    &erb_cpu14,
+                                    &erb_cpu15,
+                                }};
+
+/* ring buffers accept multiple producers, cpus beyond the array share a ring buffer */
+stain void *get_cpu_ringbuffer(void *map) {
+  uint32_t cpu_id = (uint32_t)bpf_get_smp_processor_id() % ARRAY_OF_MAPS_MAX_ENTRIES;
+  return bpf_map_lookup_elem(map, &cpu_id);
+}
+
+stain void *map__reserve_space(void *map, u64 size) {
+  void *rbuf = get_cpu_ringbuffer(map);
+  if (!rbuf) return NULL;
+
+  return bpf_ringbuf_reserve(rbuf, size, 0);
+};
+
+stain int map__reserve_submit(void *data) {
+  if (!data) return TDCE_NULL_POINTER;
+
+  bpf_ringbuf_submit(data, 0);
+
+  return TDC_SUCCESS;
+};
+
+stain int map__ringbuf_output(void *map, void *data, u64 size) {
+  if (!map || !data) return TDCE_NULL_POINTER;
+
+  if (bpf_ringbuf_output(map, data, size, 0) != 0) return TDCE_MAP_SUBMIT;
+
+  return TDC_SUCCESS;
+}
+
+stain int map__pringbuf_submit(void *map, void *data, u64 size) {
+  if (!data) return TDCE_NULL_POINTER;
+
+  void *rbuf = get_cpu_ringbuffer(map);
+  if (!rbuf) return TDCE_MAP_SUBMIT;
+
+  return map__ringbuf_output(rbuf, data, size);
+}
+
+stain int map__discard(void *data) {
+  if (!data) return TDCE_NULL_POINTER;
+
+  bpf_ringbuf_discard(data, 0);
+
+  return TDC_SUCCESS;
+};
+
+/*
+* 
+* PERF_EVENT_ARRAY
+* This map is used as an fallback map 
+* on kernel version which do not support ringbuf
+* 
+*/
+struct {
+  __uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
+  __uint(key_size, sizeof(int));
+  __uint(value_size, sizeof(u32));
+} events SEC(".maps");
+
+stain int map__submit(void *ctx, void *map, void *data, u64 size) {
+  if (!map || !data) return TDCE_NULL_POINTER;
+
+  if (bpf_perf_event_output(ctx, map, BPF_F_CURRENT_CPU, data, size) != 0) return TDCE_MAP_SUBMIT;
+
+  return TDC_SUCCESS;
+};
+
+/*
+*
+* Capture mode, rewritten by userspace before loading:
+* 0 - kprobes on the syscall wrappers, 1 - raw_syscalls tracepoints.
+* In tracepoint mode the context of the programs is bpf_raw_tracepoint_args.
+*
+*/
+const volatile u8 tarian_tracepoint = 0;
+
+/*
+*
+* Number of plaintext bytes captured per TLS read or write,
+* rewritten by userspace before loading. Capped to MAX_TLS_DATA_SIZE.
+*
+*/
+const volatile u32 tarian_tls_max_data = MAX_TLS_DATA_SIZE;
+
+/*
+*
+* LRU_HASH
+* Holds the buffer passed to SSL_read and SSL_write, keyed by pid_tgid,
+* until the uretprobe reports how many bytes were transferred.
+*
+*/
+BPF_LRU_HASH(tls_buffers, u64, u64, MAX_TLS_BUFFERS);
+
+/*
+*
+* LRU_HASH
+* Holds the buffer passed to crypto/tls.(*Conn).Read, keyed by goroutine,
+* until one of its RET instructions reports how many bytes were read.
+*
+*/
+BPF_LRU_HASH(go_tls_reads, go_tls_read_key_t, u64, MAX_TLS_BUFFERS);
+
+/*
+*
+* LRU_HASH
+* Marks the mmap and mprotect calls making memory executable, keyed by
+* pid_tgid, so that only their exit is reported.
+*
+*/
+BPF_LRU_HASH(exec_mem_calls, u64, u8, MAX_EXEC_MEM_CALLS);
+
+/*
+*
+* LRU_HASH
+* Holds the mprotect calls requesting PROT_EXEC, keyed by pid_tgid, until
+* the syscall returns. security_file_mprotect marks the calls changing
+* anonymous or writable memory.
+*
+*/
+BPF_LRU_HASH(mprotect_calls, u64, mprotect_call_t, MAX_EXEC_MEM_CALLS);
+
+/*
+*
+* LRU_HASH
+* Holds the user buffers passed to recvfrom and recvmsg, keyed by pid_tgid,
+* until the syscall returns and they hold the payload and source address.
+*
+*/
+BPF_LRU_HASH(recv_calls, u64, recv_call_t, MAX_RECV_CALLS);
+
+/*
+*
+* LRU_HASH
+* Holds the task of the process owning each tcp socket, keyed by the address
+* of the socket, from tcp_connect or inet_csk_accept until the socket is closed.
+*
+*/
+BPF_LRU_HASH(sock_owners, u64, task_meta_data_t, MAX_SOCK_OWNERS);
+
+/*
+*
+* PROG_ARRAY
+* These maps hold the tracepoint programs of the captured syscalls,
+* indexed by syscall number and tail called from raw_syscalls:sys_enter
+* and raw_syscalls:sys_exit. They are filled by userspace.
+*
+*/
+struct {
+  __uint(type, BPF_MAP_TYPE_PROG_ARRAY);
+  __uint(max_entries, MAX_SYSCALL_NR);
+  __type(key, u32);
+  __type(value, u32);
+} sys_enter_calls SEC(".maps");
+
+struct {
+  __uint(type, BPF_MAP_TYPE_PROG_ARRAY);
+  __uint(max_entries, MAX_SYSCALL_NR);
+  __type(key, u32);
+  __type(value, u32);
+} sys_exit_calls SEC(".maps");
+
+/*
+*
+* HASH
+* Policy rules of the enforcement programs, written by userspace.
+* The value is the action taken on a match: TARIAN_ACTION_DENY or TARIAN_ACTION_AUDIT.
+*
+*/
+BPF_MAP(deny_exec, BPF_MAP_TYPE_HASH, file_rule_key_t, u8, MAX_POLICY_RULES);
+BPF_MAP(deny_open, BPF_MAP_TYPE_HASH, file_rule_key_t, u8, MAX_POLICY_RULES);
+BPF_MAP(deny_connect, BPF_MAP_TYPE_HASH, connect_rule_key_t, u8, MAX_POLICY_RULES);
 
 #endif
\ No newline at end of file
@@ -64,4 +64,35 @@ stain struct task_struct *get_task_parent(struct task_struct *task) {
   return BPF_CORE_READ(task, parent);
 }
 
+// task->files->fdt->fd[fd], NULL if fd is out of the table
+stain struct file *get_task_file(struct task_struct *task, int fd) {
+  struct fdtable *fdt = BPF_CORE_READ(task, files, fdt);
+  if (fd < 0 || fd >= BPF_CORE_READ(fdt, max_fds))
+    return NULL;
+
+  struct file **fds = BPF_CORE_READ(fdt, fd);
+  struct file *file = NULL;
+  bpf_core_read(&file, sizeof(file), &fds[fd]);
+
+  return file;
+}
+
+// task->real_cred
+stain const struct cred *get_task_cred(struct task_struct *task) {
+  return BPF_CORE_READ(task, real_cred);
+}
+
+// task->real_cred->user_ns->ns.inum
+stain unsigned int get_task_user_ns_id(struct task_struct *task) {
+  return BPF_CORE_READ(task, real_cred, user_ns, ns.inum);
+}
+
+// kernel_cap_t is a pair of u32 before linux 6.3 and a u64 since, both hold the same 64 bits
+stain u64 get_cap_bits(const kernel_cap_t *cap) {
+  u64 bits = 0;
+  bpf_core_read(&bits, sizeof(bits), cap);
+
+  return bits;
+}
+
 #endif
@@ -110,4 +110,40 @@ typedef struct tarian_stats {
   u64 n_trgs_unknown;
 } tarian_stats_t;  /* 48B */
 
+/* key of the policy rules matching a file, the inode of the file */
+typedef struct file_rule_key {
+  u64 ino;
+  u32 dev; /* kernel encoding of the device of the file system */
+  u32 pad;
+} file_rule_key_t; /* 16B */
+
+/* key of the policy rules matching a connection, port 0 matches every port */
+typedef struct connect_rule_key {
+  u8 addr[16]; /* ipv4 address in the first 4 bytes or ipv6 address, network byte order */
+  u16 port;    /* host byte order */
+  u16 family;  /* AF_INET or AF_INET6 */
+} connect_rule_key_t; /* 20B */
+
+/* user buffers of a recvfrom or recvmsg call, read back when the syscall returns */
+typedef struct recv_call {
+  u64 buf;     /* payload buffer of recvfrom, struct user_msghdr of recvmsg */
+  u64 addr;    /* source address buffer of recvfrom */
+  u64 addrlen; /* length of the source address of recvfrom, int * */
+} recv_call_t; /* 24B */
+
+/* arguments of an mprotect call requesting PROT_EXEC, reported when the syscall returns */
+typedef struct mprotect_call {
+  u64 start;
+  u64 len;
+  s32 prot;
+  u32 exec_mem; /* set if a mapping of the range was anonymous or writable, and not executable */
+} mprotect_call_t; /* 24B */
+
+/* goroutine reading from a Go crypto/tls connection, the g pointer of the goroutine in its process */
+typedef struct go_tls_read_key {
+  u64 g;
+  u32 tgid;
+  u32 pad;
+} go_tls_read_key_t; /* 16B */
+
 #endif
\ No newline at end of file
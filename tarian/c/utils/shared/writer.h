@@ -20,6 +20,7 @@ stain void write_s64(uint8_t *, uint64_t *, int64_t);
 stain void write_ipv6(uint8_t *, uint64_t *, uint32_t ipv6[16]);
 stain int16_t write_str(uint8_t *, uint64_t *, unsigned long, uint16_t, enum memory);
 stain int16_t write_byte_arr(uint8_t *, uint64_t *, unsigned long, uint16_t, enum memory);
+stain int16_t write_raw_bytes(uint8_t *, uint64_t *, unsigned long, uint16_t, enum memory);
 
 stain void write_u8(uint8_t *buf, uint64_t *pos, uint8_t data) {
   *((uint8_t *)&buf[SAFE_ACCESS(*pos)]) = data;
@@ -164,6 +165,34 @@ stain int16_t write_byte_arr(uint8_t *buf, uint64_t *pos, unsigned long data_ptr
   return (int16_t)written_bytes;
 };
 
+stain int16_t write_raw_bytes(uint8_t *buf, uint64_t *pos, unsigned long data_ptr, uint16_t n, enum memory mr) {
+  /*
+    [len..bytes....], the n bytes are copied as is, including their NUL bytes
+  */
+  int resp = 0;
+  if (n > MAX_STRING_SIZE)
+    n = MAX_STRING_SIZE;
+
+  uint16_t *len = ((uint16_t *)&buf[SAFE_ACCESS(*pos)]);
+  *len = 0;
+  *pos += sizeof(uint16_t);
+
+  if (mr == USER) {
+    resp = bpf_probe_read_user(&buf[SAFE_ACCESS(*pos)], n, (void *)data_ptr);
+  } else {
+    resp = bpf_probe_read_kernel(&buf[SAFE_ACCESS(*pos)], n, (void *)data_ptr);
+  }
+
+  if (resp != 0) {
+    return -1;
+  }
+
+  *len = n;
+  *pos += n;
+
+  return (int16_t)n;
+};
+
 #define MAX_IOVEC_COUNT 32
 stain void write_iovec_arr(uint8_t *buf, uint64_t *pos, unsigned long iov_ptr, unsigned long iov_count) {
   /*
@@ -199,8 +228,15 @@ stain void write_iovec_arr(uint8_t *buf, uint64_t *pos, unsigned long iov_ptr, u
 
 #define MAX_UNIX_SOCKET_PATH 108 + 1
 stain void write_sockaddr(uint8_t *buf, uint64_t *pos, unsigned long data_ptr, uint16_t addrlen) {
-  if (bpf_probe_read((void *)&buf[MAX_PARAM_SIZE], SAFE_ACCESS(addrlen), (void *)data_ptr) != 0) return;
-  
+  /*
+    [family 1B][...address...], only the family of unread or unsupported addresses
+  */
+  if (data_ptr == 0 || addrlen == 0 ||
+      bpf_probe_read((void *)&buf[MAX_PARAM_SIZE], SAFE_ACCESS(addrlen), (void *)data_ptr) != 0) {
+    write_u8(buf, pos, AF_UNSPEC);
+    return;
+  }
+
   struct sockaddr *sockaddr = (struct sockaddr *)&buf[MAX_PARAM_SIZE];
   uint16_t socket_family = sockaddr->sa_family;
 
@@ -247,6 +283,9 @@ stain void write_sockaddr(uint8_t *buf, uint64_t *pos, unsigned long data_ptr, u
       write_str(buf, pos, start_reading_point, MAX_UNIX_SOCKET_PATH, KERNEL);
       break;
     }
+    default:
+      write_u8(buf, pos, socket_family);
+      break;
   }
 }
 
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"github.com/cilium/ebpf/link"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/err"
+	"github.com/intelops/tarian-detector/pkg/eventparser"
+)
+
+var captureErr = err.New("tarian.capture")
+
+// tracepointConstant is the read-only variable of the eBPF programs selecting the tracepoint capture mode.
+const tracepointConstant = "tarian_tracepoint"
+
+// CaptureMode selects the hooks the syscalls are captured from. Both modes send the same events.
+type CaptureMode int
+
+const (
+	// KprobeCapture attaches a kprobe and a kretprobe to the kernel symbol of every syscall.
+	KprobeCapture CaptureMode = iota
+	// TracepointCapture attaches a single program to each of the raw_syscalls:sys_enter and
+	// raw_syscalls:sys_exit tracepoints, which tail calls the programs of the captured syscalls.
+	// Tracepoints are a stable ABI and do not depend on the kernel symbols.
+	TracepointCapture
+)
+
+// captureModes maps the name of every capture mode to its value.
+var captureModes = map[string]CaptureMode{
+	"kprobe":     KprobeCapture,
+	"tracepoint": TracepointCapture,
+}
+
+// ParseCaptureMode returns the capture mode with the given name, kprobe or tracepoint.
+func ParseCaptureMode(name string) (CaptureMode, error) {
+	mode, ok := captureModes[name]
+	if !ok {
+		return KprobeCapture, captureErr.Throwf("unknown capture mode %q", name)
+	}
+
+	return mode, nil
+}
+
+// String returns the name of the capture mode.
+func (c CaptureMode) String() string {
+	switch c {
+	case KprobeCapture:
+		return "kprobe"
+	case TracepointCapture:
+		return "tracepoint"
+	default:
+		return "unknown"
+	}
+}
+
+// Tracepoint hooks of the dispatcher programs of the tracepoint capture mode.
+var (
+	sysEnterHook = link.RawTracepointOptions{Name: "sys_enter"}
+	sysExitHook  = link.RawTracepointOptions{Name: "sys_exit"}
+)
+
+// addTracepointPrograms registers the tracepoint programs of the supported probes in the tail call maps,
+// indexed by syscall number, and adds the dispatcher programs to the module. Of the optional probes,
+// only those of the given events are registered.
+func addTracepointPrograms(m *ebpf.Module, objs *tarianObjects, events []string) error {
+	for _, pd := range syscallProbes {
+		if !pd.supported() || !pd.enabled(events) {
+			continue
+		}
+
+		entry, exit, err := pd.tracepointPrograms(&objs.tarianPrograms)
+		if err != nil {
+			return err
+		}
+
+		id := uint32(eventparser.SyscallId(pd.name))
+		if err := objs.SysEnterCalls.Put(id, entry); err != nil {
+			return captureErr.Throwf("%s: %v", pd.name, err)
+		}
+
+		if err := objs.SysExitCalls.Put(id, exit); err != nil {
+			return captureErr.Throwf("%s: %v", pd.name, err)
+		}
+	}
+
+	m.AddProgram(ebpf.NewProgram(objs.TdfSysEnter, ebpf.NewHookInfo().RawTracepoint(sysEnterHook)))
+	m.AddProgram(ebpf.NewProgram(objs.TdfSysExit, ebpf.NewHookInfo().RawTracepoint(sysExitHook)))
+
+	return nil
+}
+
+// tracepointHooks returns the dispatcher programs of the module, if it captures the syscalls from tracepoints.
+func tracepointHooks(m *ebpf.Module) []*ebpf.ProgramInfo {
+	var progs []*ebpf.ProgramInfo
+	if m == nil {
+		return progs
+	}
+
+	for _, prog := range m.GetPrograms() {
+		hook := prog.GetHook()
+		if hook.GetHookType() == ebpf.RawTracepoint && (hook.GetHookName() == sysEnterHook.Name || hook.GetHookName() == sysExitHook.Name) {
+			progs = append(progs, prog)
+		}
+	}
+
+	return progs
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"testing"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+)
+
+// TestParseCaptureMode tests the ParseCaptureMode function
+func TestParseCaptureMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    CaptureMode
+		wantErr bool
+	}{
+		{name: "kprobe", want: KprobeCapture},
+		{name: "tracepoint", want: TracepointCapture},
+		{name: "fentry", want: KprobeCapture, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCaptureMode(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCaptureMode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseCaptureMode() = %v, want %v", got, tt.want)
+			}
+
+			if !tt.wantErr && got.String() != tt.name {
+				t.Errorf("CaptureMode.String() = %v, want %v", got.String(), tt.name)
+			}
+		})
+	}
+}
+
+// TestProbeDescriptor_tracepointPrograms tests the tracepointPrograms function
+func TestProbeDescriptor_tracepointPrograms(t *testing.T) {
+	pd := probeDescriptor{name: "execve", tpEntry: "tdf_execve_te", tpExit: "tdf_execve_tr"}
+
+	tests := []struct {
+		name    string
+		objs    *tarianPrograms
+		wantErr bool
+	}{
+		{
+			name: "programs loaded",
+			objs: &tarianPrograms{TdfExecveTe: &cilium_ebpf.Program{}, TdfExecveTr: &cilium_ebpf.Program{}},
+		},
+		{
+			name:    "exit program missing",
+			objs:    &tarianPrograms{TdfExecveTe: &cilium_ebpf.Program{}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, exit, err := pd.tracepointPrograms(tt.objs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("probeDescriptor.tracepointPrograms() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (entry != tt.objs.TdfExecveTe || exit != tt.objs.TdfExecveTr) {
+				t.Errorf("probeDescriptor.tracepointPrograms() = %v, %v", entry, exit)
+			}
+		})
+	}
+}
+
+// TestListProbes_tracepoint tests that the probes captured from tracepoints report the dispatcher hooks
+func TestListProbes_tracepoint(t *testing.T) {
+	m := ebpf.NewModule("test")
+	enter := ebpf.NewProgram(&cilium_ebpf.Program{}, ebpf.NewHookInfo().RawTracepoint(sysEnterHook))
+	exit := ebpf.NewProgram(&cilium_ebpf.Program{}, ebpf.NewHookInfo().RawTracepoint(sysExitHook))
+	m.AddProgram(enter)
+	m.AddProgram(exit)
+
+	h := ebpf.NewHandler("test")
+	h.AddAttachedProgram(enter, nil)
+	h.AddAttachedProgram(exit, nil)
+
+	for _, status := range ListProbes(m, h) {
+		pd := syscallProbes[0]
+		for _, p := range syscallProbes {
+			if p.name == status.Name {
+				pd = p
+			}
+		}
+
+		if status.Enabled != pd.supported() || status.Attached != pd.supported() {
+			t.Errorf("ListProbes() %s = %+v, want enabled and attached %v", status.Name, status, pd.supported())
+		}
+
+		if pd.supported() && (len(status.Hooks) != 2 || status.Hooks[0] != "RawTracepoint/sys_enter") {
+			t.Errorf("ListProbes() %s hooks = %v", status.Name, status.Hooks)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var cgroupErr = err.New("tarian.cgroup")
+
+// DefaultCgroupRoot is the mount point of the cgroup v2 hierarchy searched for the cgroups of the pods.
+const DefaultCgroupRoot = "/sys/fs/cgroup"
+
+// cgroupHooks maps the cgroup programs to the attach types they are attached with.
+var cgroupHooks = []struct {
+	attach  cilium_ebpf.AttachType
+	program func(*tarianPrograms) *cilium_ebpf.Program
+}{
+	{cilium_ebpf.AttachCGroupInet4Connect, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCgroupConnect4 }},
+	{cilium_ebpf.AttachCGroupInet6Connect, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCgroupConnect6 }},
+	{cilium_ebpf.AttachCGroupUDP4Sendmsg, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCgroupSendmsg4 }},
+	{cilium_ebpf.AttachCGroupUDP6Sendmsg, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCgroupSendmsg6 }},
+	{cilium_ebpf.AttachCGroupInetSockCreate, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCgroupSockCreate }},
+}
+
+// CgroupMonitor attaches the cgroup programs of the tarian module to the cgroups of the workloads on demand.
+// The programs report the connections, the unconnected UDP messages and the sockets created by the
+// processes of the cgroup and its descendants, tagged with their cgroup id. They never deny an operation.
+type CgroupMonitor struct {
+	mu sync.Mutex
+
+	programs *tarianPrograms        // Programs of the loaded module, nil until the monitor is bound
+	links    map[string][]link.Link // Links of the monitored cgroups, keyed by path
+}
+
+// NewCgroupMonitor creates a cgroup monitor. Passing it in ModuleOptions loads the cgroup programs.
+func NewCgroupMonitor() *CgroupMonitor {
+	return &CgroupMonitor{
+		links: make(map[string][]link.Link),
+	}
+}
+
+// bind sets the programs attached by the monitor.
+func (c *CgroupMonitor) bind(objs *tarianPrograms) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.programs = objs
+}
+
+// Attach attaches the cgroup programs to the cgroup v2 directory at path. On error, the programs
+// attached to the cgroup so far are detached.
+func (c *CgroupMonitor) Attach(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.programs == nil {
+		return cgroupErr.Throw("cgroup monitor is not bound to a loaded module")
+	}
+
+	path = filepath.Clean(path)
+	if _, ok := c.links[path]; ok {
+		return cgroupErr.Throwf("%s: already monitored", path)
+	}
+
+	var links []link.Link
+	for _, ch := range cgroupHooks {
+		hook := ebpf.NewHookInfo().Cgroup(link.CgroupOptions{Path: path, Attach: ch.attach})
+
+		l, err := hook.AttachProbe(ch.program(c.programs))
+		if err != nil {
+			closeLinks(links)
+			return cgroupErr.Throwf("%s: %v", hook, err)
+		}
+
+		links = append(links, l)
+	}
+
+	c.links[path] = links
+	return nil
+}
+
+// Detach detaches the cgroup programs from the cgroup at path.
+func (c *CgroupMonitor) Detach(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path = filepath.Clean(path)
+	links, ok := c.links[path]
+	if !ok {
+		return cgroupErr.Throwf("%s: not monitored", path)
+	}
+
+	delete(c.links, path)
+	if err := closeLinks(links); err != nil {
+		return cgroupErr.Throwf("%s: %v", path, err)
+	}
+
+	return nil
+}
+
+// Paths returns the monitored cgroups in a stable order.
+func (c *CgroupMonitor) Paths() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var paths []string
+	for path := range c.links {
+		paths = append(paths, path)
+	}
+
+	slices.Sort(paths)
+	return paths
+}
+
+// Close detaches the cgroup programs from every monitored cgroup.
+func (c *CgroupMonitor) Close() error {
+	var errs []error
+	for _, path := range c.Paths() {
+		if err := c.Detach(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		return cgroupErr.Throwf("%v", errs)
+	}
+
+	return nil
+}
+
+// closeLinks closes every link and returns the first error.
+func closeLinks(links []link.Link) error {
+	var first error
+	for _, l := range links {
+		if err := l.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// FindPodCgroup returns the cgroup of the pod with the given uid under the cgroup v2 hierarchy at root.
+// Both the cgroupfs layout, pod<uid>, and the systemd layout, pod<uid with underscores>.slice, are searched.
+func FindPodCgroup(root, podUID string) (string, error) {
+	if len(podUID) == 0 {
+		return "", cgroupErr.Throw("missing pod uid")
+	}
+
+	names := []string{
+		"pod" + podUID,
+		"pod" + strings.ReplaceAll(podUID, "-", "_") + ".slice",
+	}
+
+	var found string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if slices.Contains(names, d.Name()) || strings.HasSuffix(d.Name(), "-"+names[1]) {
+			found = path
+			return fs.SkipAll
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", cgroupErr.Throwf("%v", err)
+	}
+
+	if len(found) == 0 {
+		return "", cgroupErr.Throwf("no cgroup found for pod %s under %s", podUID, root)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestFindPodCgroup tests the FindPodCgroup function
+func TestFindPodCgroup(t *testing.T) {
+	root := t.TempDir()
+	dirs := []string{
+		"kubepods/besteffort/pod0a1b2c3d-0000-4000-8000-000000000001/container",
+		"kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod0a1b2c3d_0000_4000_8000_000000000002.slice",
+	}
+
+	for _, dir := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		uid     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "cgroupfs layout",
+			uid:  "0a1b2c3d-0000-4000-8000-000000000001",
+			want: filepath.Join(root, "kubepods/besteffort/pod0a1b2c3d-0000-4000-8000-000000000001"),
+		},
+		{
+			name: "systemd layout",
+			uid:  "0a1b2c3d-0000-4000-8000-000000000002",
+			want: filepath.Join(root, dirs[1]),
+		},
+		{
+			name:    "unknown pod",
+			uid:     "0a1b2c3d-0000-4000-8000-000000000003",
+			wantErr: true,
+		},
+		{
+			name:    "missing uid",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindPodCgroup(root, tt.uid)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindPodCgroup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("FindPodCgroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCgroupMonitor tests the cgroup monitor without a loaded module
+func TestCgroupMonitor(t *testing.T) {
+	c := NewCgroupMonitor()
+
+	if err := c.Attach(t.TempDir()); err == nil {
+		t.Errorf("CgroupMonitor.Attach() error = %v, wantErr %v", err, true)
+	}
+
+	if err := c.Detach(t.TempDir()); err == nil {
+		t.Errorf("CgroupMonitor.Detach() error = %v, wantErr %v", err, true)
+	}
+
+	if paths := c.Paths(); len(paths) != 0 {
+		t.Errorf("CgroupMonitor.Paths() = %v, want none", paths)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Errorf("CgroupMonitor.Close() error = %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/utils"
+	"golang.org/x/sys/unix"
+)
+
+// CheckResult reports a capability of the node probed by Check.
+type CheckResult struct {
+	Name   string // Name of the capability, e.g. ringbuf
+	Ok     bool   // Whether the capability is available
+	Detail string // Value of the capability or reason it is unavailable
+}
+
+// Check probes the capabilities of the running kernel used by the detector, without loading
+// the tarian module. The kernel symbol table is used to check the syscall symbols and can be
+// nil if it is not readable.
+func Check(ks *ebpf.KernelSymbols) []CheckResult {
+	results := []CheckResult{
+		checkKernelVersion(),
+		checkFeature("btf", ebpf.HaveBTF(), "CO-RE relocations available"),
+		checkRingBuf(),
+		checkFeature("kprobe multi", ebpf.HaveKprobeMulti(), "supported"),
+		checkFeature("bpf_loop", ebpf.HaveBpfLoop(), "supported"),
+		checkMemlock(),
+	}
+
+	if ks == nil {
+		return append(results, CheckResult{Name: "kallsyms", Detail: "kernel symbols are not readable, probes are attached by name"})
+	}
+
+	results = append(results, CheckResult{Name: "kallsyms", Ok: true, Detail: ebpf.KallsymsPath})
+
+	for _, pd := range syscallProbes {
+		if !pd.supported() {
+			continue
+		}
+
+		results = append(results, checkSyscallSymbol(ks, pd.name))
+	}
+
+	return results
+}
+
+// PredictProbes reports, for every registered probe, whether it would be attached on the running
+// kernel: the syscall must exist on the architecture and its kernel symbol must be exported.
+// The Enabled field of a status holds the prediction and Reason explains a negative one.
+func PredictProbes(ks *ebpf.KernelSymbols) []ProbeStatus {
+	statuses := make([]ProbeStatus, 0, len(syscallProbes))
+
+	for _, pd := range syscallProbes {
+		status := ProbeStatus{Name: pd.name}
+
+		if !pd.supported() {
+			status.Reason = fmt.Sprintf("syscall not available on %s", runtime.GOARCH)
+			statuses = append(statuses, status)
+			continue
+		}
+
+		status.Enabled = true
+		for _, hook := range []*ebpf.HookInfo{pd.entryHook(), pd.exitHook()} {
+			if err := hook.Resolve(ks); err != nil {
+				status.Enabled = false
+				status.Reason = err.Error()
+			}
+
+			status.Hooks = append(status.Hooks, hook.String())
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// checkSyscallSymbol reports whether the kernel exports the syscall wrapper of the named syscall.
+// Without it, the function of the syscall taking the arguments directly is named if there is one.
+func checkSyscallSymbol(ks *ebpf.KernelSymbols, name string) CheckResult {
+	symbol := ebpf.SyscallSymbol(name)
+	if ks.Has(symbol) {
+		return CheckResult{Name: "symbol " + name, Ok: true, Detail: symbol}
+	}
+
+	detail := fmt.Sprintf("%s is not available", symbol)
+	if unwrapped, ok := ebpf.UnwrappedSyscallSymbol(ks, name); ok {
+		detail += fmt.Sprintf(", %s takes the arguments without pt_regs and is not probed", unwrapped)
+	}
+
+	return CheckResult{Name: "symbol " + name, Detail: detail}
+}
+
+// checkFeature turns the result of a feature probe into a CheckResult.
+func checkFeature(name string, err error, detail string) CheckResult {
+	if err != nil {
+		return CheckResult{Name: name, Detail: err.Error()}
+	}
+
+	return CheckResult{Name: name, Ok: true, Detail: detail}
+}
+
+// checkKernelVersion reports the release of the running kernel.
+func checkKernelVersion() CheckResult {
+	release, err := utils.KernelRelease()
+	if err != nil {
+		return CheckResult{Name: "kernel version", Detail: err.Error()}
+	}
+
+	v, err := utils.CurrentKernelVersion()
+	if err != nil {
+		return CheckResult{Name: "kernel version", Detail: err.Error()}
+	}
+
+	return CheckResult{
+		Name:   "kernel version",
+		Ok:     true,
+		Detail: fmt.Sprintf("%d.%d.%d (%s)", v>>16, (v>>8)&0xff, v&0xff, release),
+	}
+}
+
+// checkRingBuf reports the transport the events would be sent through.
+func checkRingBuf() CheckResult {
+	if ebpf.HaveRingBuf() {
+		return CheckResult{Name: "ringbuf", Ok: true, Detail: "events sent through ring buffers"}
+	}
+
+	return CheckResult{Name: "ringbuf", Detail: "not supported, events sent through the perf event array"}
+}
+
+// checkMemlock reports the locked memory limit, which kernels before 5.11 charge eBPF objects against.
+// The limit must hold the maps of the tarian module, with the transport of the events the kernel supports.
+func checkMemlock() CheckResult {
+	cur, max, err := utils.MemlockLimit()
+	if err != nil {
+		return CheckResult{Name: "memlock", Detail: err.Error()}
+	}
+
+	detail := fmt.Sprintf("soft %s, hard %s", formatRlimit(cur), formatRlimit(max))
+	if v, err := utils.CurrentKernelVersion(); err == nil && v >= utils.KernelVersion(5, 11, 0) {
+		return CheckResult{Name: "memlock", Ok: true, Detail: detail + ", eBPF objects charged to the memory cgroup"}
+	}
+
+	spec, err := loadTarian()
+	if err != nil {
+		return CheckResult{Name: "memlock", Detail: fmt.Sprintf("%s, size of the maps unknown: %v", detail, err)}
+	}
+
+	if !ebpf.HaveRingBuf() {
+		disableRingbufs(spec)
+	}
+
+	need := specMemlock(spec, cilium_ebpf.MustPossibleCPU())
+	detail += fmt.Sprintf(", maps need %d bytes", need)
+
+	return CheckResult{Name: "memlock", Ok: cur == unix.RLIM_INFINITY || cur >= need, Detail: detail}
+}
+
+// specMemlock estimates the locked memory charged for the maps of the spec, each rounded up to pages:
+// the data of the ring buffers and the entries of the other maps, once per cpu for the per-cpu maps.
+func specMemlock(spec *cilium_ebpf.CollectionSpec, cpus int) uint64 {
+	page := uint64(os.Getpagesize())
+
+	var total uint64
+	for _, m := range spec.Maps {
+		var size uint64
+		switch m.Type {
+		case cilium_ebpf.RingBuf:
+			size = uint64(m.MaxEntries)
+		case cilium_ebpf.PerCPUArray, cilium_ebpf.PerCPUHash, cilium_ebpf.LRUCPUHash:
+			size = uint64(m.KeySize+m.ValueSize) * uint64(m.MaxEntries) * uint64(cpus)
+		default:
+			size = uint64(m.KeySize+m.ValueSize) * uint64(m.MaxEntries)
+		}
+
+		total += (size + page - 1) / page * page
+	}
+
+	return total
+}
+
+// formatRlimit formats a resource limit in bytes.
+func formatRlimit(v uint64) string {
+	if v == unix.RLIM_INFINITY {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d bytes", v)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+)
+
+// TestPredictProbes tests the PredictProbes function
+func TestPredictProbes(t *testing.T) {
+	execve := ebpf.SyscallSymbol("execve")
+
+	tests := []struct {
+		name       string
+		kallsyms   string
+		wantExecve bool
+		wantReason string
+	}{
+		{
+			name:       "without kernel symbols",
+			wantExecve: true,
+		},
+		{
+			name:       "symbol exported",
+			kallsyms:   "ffffffff81000000 T " + execve + "\n",
+			wantExecve: true,
+		},
+		{
+			name:       "symbol missing",
+			kallsyms:   "ffffffff81000000 T do_sys_open\n",
+			wantReason: execve,
+		},
+		{
+			name:       "only the syscall taking the arguments directly",
+			kallsyms:   "ffffffff81000000 T __se_sys_execve\nffffffff81000100 T sys_execve\n",
+			wantReason: execve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ks *ebpf.KernelSymbols
+			if len(tt.kallsyms) != 0 {
+				var err error
+				if ks, err = ebpf.ParseKernelSymbols(strings.NewReader(tt.kallsyms)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := PredictProbes(ks)
+			if len(got) != len(syscallProbes) {
+				t.Fatalf("PredictProbes() = %v probes, want %v", len(got), len(syscallProbes))
+			}
+
+			for _, status := range got {
+				if status.Name != "execve" {
+					continue
+				}
+
+				if status.Enabled != tt.wantExecve {
+					t.Errorf("PredictProbes() execve enabled = %v, want %v", status.Enabled, tt.wantExecve)
+				}
+
+				if !strings.Contains(status.Reason, tt.wantReason) {
+					t.Errorf("PredictProbes() execve reason = %q, want %q", status.Reason, tt.wantReason)
+				}
+			}
+		})
+	}
+}
+
+// TestCheck tests that Check reports the symbol of every supported probe
+func TestCheck(t *testing.T) {
+	ks, err := ebpf.ParseKernelSymbols(strings.NewReader("ffffffff81000000 T " + ebpf.SyscallSymbol("execve") + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	symbols := make(map[string]bool)
+	for _, r := range Check(ks) {
+		if strings.HasPrefix(r.Name, "symbol ") {
+			symbols[strings.TrimPrefix(r.Name, "symbol ")] = r.Ok
+		}
+	}
+
+	for _, pd := range syscallProbes {
+		if ok, found := symbols[pd.name]; found != pd.supported() || ok != (pd.name == "execve") {
+			t.Errorf("Check() symbol %s = %v, %v", pd.name, ok, found)
+		}
+	}
+}
+
+// Test_checkSyscallSymbol tests the checkSyscallSymbol function
+func Test_checkSyscallSymbol(t *testing.T) {
+	execve := ebpf.SyscallSymbol("execve")
+
+	tests := []struct {
+		name       string
+		kallsyms   string
+		want       bool
+		wantDetail string
+	}{
+		{name: "wrapper exported", kallsyms: "ffffffff81000000 T " + execve + "\n", want: true, wantDetail: execve},
+		{name: "wrapper missing", kallsyms: "ffffffff81000000 T do_sys_open\n", wantDetail: execve + " is not available"},
+		{name: "unwrapped syscall", kallsyms: "ffffffff81000000 T sys_execve\n", wantDetail: "sys_execve takes the arguments without pt_regs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := ebpf.ParseKernelSymbols(strings.NewReader(tt.kallsyms))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := checkSyscallSymbol(ks, "execve")
+			if got.Ok != tt.want || !strings.Contains(got.Detail, tt.wantDetail) {
+				t.Errorf("checkSyscallSymbol() = %+v, want %v and %q", got, tt.want, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// Test_specMemlock tests the specMemlock function
+func Test_specMemlock(t *testing.T) {
+	page := uint64(os.Getpagesize())
+	pages := func(size uint64) uint64 { return (size + page - 1) / page * page }
+	ringbufs := func() *cilium_ebpf.CollectionSpec {
+		spec := &cilium_ebpf.CollectionSpec{Maps: map[string]*cilium_ebpf.MapSpec{
+			"scratch": {Type: cilium_ebpf.PerCPUArray, KeySize: 4, ValueSize: 4092, MaxEntries: 1},
+			"events":  {Type: cilium_ebpf.Array, KeySize: 4, ValueSize: 4, MaxEntries: 16},
+		}}
+
+		for i := 0; i < 16; i++ {
+			spec.Maps[fmt.Sprintf("erb_cpu%d", i)] = &cilium_ebpf.MapSpec{Type: cilium_ebpf.RingBuf, MaxEntries: 8 << 20}
+		}
+
+		return spec
+	}
+
+	disabled := ringbufs()
+	disableRingbufs(disabled)
+
+	tests := []struct {
+		name string
+		spec *cilium_ebpf.CollectionSpec
+		cpus int
+		want uint64
+	}{
+		{name: "ring buffers", spec: ringbufs(), cpus: 4, want: 16*(8<<20) + pages(4*4096) + page},
+		{name: "ring buffers disabled", spec: disabled, cpus: 2, want: 16*page + pages(2*4096) + page},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := specMemlock(tt.spec, tt.cpus); got != tt.want {
+				t.Errorf("specMemlock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"slices"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+)
+
+// kernelHooks maps the programs attached to kernel functions other than the syscalls to their functions.
+// They report the events of the schema hooks, or complete the events of a syscall, and are attached in
+// every capture mode.
+var kernelHooks = []struct {
+	function string
+	ret      bool   // Attached to the return of the function, as kretprobe
+	event    string // Name of the event reported or completed by the program
+	optional bool   // Captured only if enabled in ModuleOptions.Events
+	program  func(*tarianPrograms) *cilium_ebpf.Program
+}{
+	{"commit_creds", false, "commit_creds", false, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfCommitCreds }},
+	{"do_init_module", false, "do_init_module", false, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfDoInitModule }},
+	{"security_file_mprotect", false, "mprotect", false, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfSecurityFileMprotect }},
+	{"tcp_connect", false, "connection_connect", true, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfTcpConnect }},
+	{"inet_csk_accept", true, "connection_accept", true, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfInetCskAccept }},
+	{"tcp_set_state", false, "connection_state", true, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfTcpSetState }},
+	{"tcp_close", false, "connection_close", true, func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfTcpClose }},
+}
+
+// kernelPrograms pairs the loaded kernel function programs with their kprobes and kretprobes.
+// The programs of the optional events left disabled are listed, but never attached.
+func kernelPrograms(objs *tarianPrograms, events []string) []*ebpf.ProgramInfo {
+	var progs []*ebpf.ProgramInfo
+	for _, kh := range kernelHooks {
+		hook := ebpf.NewHookInfo().Kprobe(kh.function)
+		if kh.ret {
+			hook = ebpf.NewHookInfo().Kretprobe(kh.function)
+		}
+
+		prog := ebpf.NewProgram(kh.program(objs), hook)
+		if kh.optional && !slices.Contains(events, kh.event) {
+			prog.Disable()
+		}
+
+		progs = append(progs, prog)
+	}
+
+	return progs
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"slices"
+	"testing"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+)
+
+// TestKernelPrograms tests the kprobes of the kernel function programs
+func TestKernelPrograms(t *testing.T) {
+	objs := &tarianPrograms{
+		TdfCommitCreds:          &cilium_ebpf.Program{},
+		TdfDoInitModule:         &cilium_ebpf.Program{},
+		TdfSecurityFileMprotect: &cilium_ebpf.Program{},
+		TdfTcpConnect:           &cilium_ebpf.Program{},
+		TdfInetCskAccept:        &cilium_ebpf.Program{},
+		TdfTcpSetState:          &cilium_ebpf.Program{},
+		TdfTcpClose:             &cilium_ebpf.Program{},
+	}
+
+	tests := []struct {
+		name    string
+		events  []string
+		enabled []string
+	}{
+		{name: "default events", events: nil, enabled: []string{"commit_creds", "do_init_module", "mprotect"}},
+		{
+			name:    "connection events enabled",
+			events:  []string{"connection_state", "connection_close"},
+			enabled: []string{"commit_creds", "do_init_module", "mprotect", "connection_state", "connection_close"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progs := kernelPrograms(objs, tt.events)
+			if len(progs) != len(kernelHooks) {
+				t.Fatalf("kernelPrograms() = %v programs, want %v", len(progs), len(kernelHooks))
+			}
+
+			for i, prog := range progs {
+				want := "Kprobe/" + kernelHooks[i].function
+				if kernelHooks[i].ret {
+					want = "Kretprobe/" + kernelHooks[i].function
+				}
+				if prog.GetHook().String() != want {
+					t.Errorf("kernelPrograms()[%d] = %v, want %v", i, prog.GetHook().String(), want)
+				}
+
+				if prog.GetName() == nil {
+					t.Errorf("kernelPrograms()[%d] has no program", i)
+				}
+
+				if enabled := slices.Contains(tt.enabled, kernelHooks[i].event); prog.GetShouldAttach() != enabled {
+					t.Errorf("kernelPrograms()[%d].GetShouldAttach() = %v, want %v", i, prog.GetShouldAttach(), enabled)
+				}
+			}
+		})
+	}
+}
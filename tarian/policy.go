@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/err"
+	"golang.org/x/sys/unix"
+)
+
+var policyErr = err.New("tarian.policy")
+
+// Action is the action taken by the enforcement programs on the operations matching a rule.
+type Action uint8
+
+const (
+	// Deny fails the operation with EPERM and reports it with the blocked verdict.
+	Deny Action = 1
+	// Audit lets the operation through and reports it with the allowed verdict.
+	Audit Action = 2
+)
+
+// String returns the name of the action.
+func (a Action) String() string {
+	switch a {
+	case Deny:
+		return "deny"
+	case Audit:
+		return "audit"
+	default:
+		return "unknown"
+	}
+}
+
+// fileRuleKey is the key of the rules matching a file, see file_rule_key_t.
+type fileRuleKey struct {
+	Ino uint64
+	Dev uint32 // Kernel encoding of the device of the super block, major << 20 | minor
+	Pad uint32
+}
+
+// connectRuleKey is the key of the rules matching a connection, see connect_rule_key_t.
+type connectRuleKey struct {
+	Addr   [16]byte // IPv4 address in the first 4 bytes or IPv6 address
+	Port   uint16   // Zero matches every port
+	Family uint16
+}
+
+// Policy holds the enforcement rules of the BPF LSM programs. Rules added before the module is
+// loaded are written to the policy maps when it is, later rules are written immediately.
+type Policy struct {
+	mu sync.Mutex
+
+	exec    map[fileRuleKey]Action
+	open    map[fileRuleKey]Action
+	connect map[connectRuleKey]Action
+
+	maps *tarianMaps // Maps of the loaded module, nil until the policy is bound
+}
+
+// policyMap selects one of the policy maps of the loaded module.
+type policyMap func(*tarianMaps) *cilium_ebpf.Map
+
+var (
+	execRules    policyMap = func(m *tarianMaps) *cilium_ebpf.Map { return m.DenyExec }
+	openRules    policyMap = func(m *tarianMaps) *cilium_ebpf.Map { return m.DenyOpen }
+	connectRules policyMap = func(m *tarianMaps) *cilium_ebpf.Map { return m.DenyConnect }
+)
+
+// lsmHooks maps the enforcement programs to the LSM hooks they are attached to.
+var lsmHooks = []struct {
+	hook    string
+	program func(*tarianPrograms) *cilium_ebpf.Program
+}{
+	{"bprm_check_security", func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfBprmCheckSecurity }},
+	{"file_open", func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfFileOpen }},
+	{"socket_connect", func(p *tarianPrograms) *cilium_ebpf.Program { return p.TdfSocketConnect }},
+}
+
+// NewPolicy creates an empty policy. Passing it in ModuleOptions enables the enforcement programs.
+func NewPolicy() *Policy {
+	return &Policy{
+		exec:    make(map[fileRuleKey]Action),
+		open:    make(map[fileRuleKey]Action),
+		connect: make(map[connectRuleKey]Action),
+	}
+}
+
+// AddExecRule applies the action to the executions of the file at path.
+func (p *Policy) AddExecRule(path string, a Action) error {
+	key, err := newFileRuleKey(path)
+	if err != nil {
+		return err
+	}
+
+	return putRule(p, p.exec, key, a, execRules)
+}
+
+// RemoveExecRule removes the rule on the executions of the file at path.
+func (p *Policy) RemoveExecRule(path string) error {
+	key, err := newFileRuleKey(path)
+	if err != nil {
+		return err
+	}
+
+	return removeRule(p, p.exec, key, execRules)
+}
+
+// AddOpenRule applies the action to the opens of the file at path.
+func (p *Policy) AddOpenRule(path string, a Action) error {
+	key, err := newFileRuleKey(path)
+	if err != nil {
+		return err
+	}
+
+	return putRule(p, p.open, key, a, openRules)
+}
+
+// RemoveOpenRule removes the rule on the opens of the file at path.
+func (p *Policy) RemoveOpenRule(path string) error {
+	key, err := newFileRuleKey(path)
+	if err != nil {
+		return err
+	}
+
+	return removeRule(p, p.open, key, openRules)
+}
+
+// AddConnectRule applies the action to the connections to the address and port, every port if zero.
+// IPv4 rules do not match the IPv4-mapped addresses connected to from IPv6 sockets.
+func (p *Policy) AddConnectRule(ip net.IP, port uint16, a Action) error {
+	key, err := newConnectRuleKey(ip, port)
+	if err != nil {
+		return err
+	}
+
+	return putRule(p, p.connect, key, a, connectRules)
+}
+
+// RemoveConnectRule removes the rule on the connections to the address and port.
+func (p *Policy) RemoveConnectRule(ip net.IP, port uint16) error {
+	key, err := newConnectRuleKey(ip, port)
+	if err != nil {
+		return err
+	}
+
+	return removeRule(p, p.connect, key, connectRules)
+}
+
+// Len returns the number of rules of the policy.
+func (p *Policy) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.exec) + len(p.open) + len(p.connect)
+}
+
+// putRule records the rule and writes it to the policy map, if the policy is bound.
+func putRule[K comparable](p *Policy, rules map[K]Action, key K, a Action, pm policyMap) error {
+	if a != Deny && a != Audit {
+		return policyErr.Throwf("unknown action %d", a)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.maps != nil {
+		if err := pm(p.maps).Put(key, uint8(a)); err != nil {
+			return policyErr.Throwf("%v", err)
+		}
+	}
+
+	rules[key] = a
+	return nil
+}
+
+// removeRule forgets the rule and deletes it from the policy map, if the policy is bound.
+func removeRule[K comparable](p *Policy, rules map[K]Action, key K, pm policyMap) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := rules[key]; !ok {
+		return policyErr.Throwf("no rule for %+v", key)
+	}
+
+	if p.maps != nil {
+		if err := pm(p.maps).Delete(key); err != nil {
+			return policyErr.Throwf("%v", err)
+		}
+	}
+
+	delete(rules, key)
+	return nil
+}
+
+// writeRules replaces the rules of the policy map, which keeps the rules of a previous run if it is pinned.
+func writeRules[K comparable](m *cilium_ebpf.Map, rules map[K]Action) error {
+	var (
+		key   K
+		a     uint8
+		stale []K
+	)
+
+	iter := m.Iterate()
+	for iter.Next(&key, &a) {
+		if _, ok := rules[key]; !ok {
+			stale = append(stale, key)
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return policyErr.Throwf("%v", err)
+	}
+
+	for _, key := range stale {
+		if err := m.Delete(key); err != nil {
+			return policyErr.Throwf("%v", err)
+		}
+	}
+
+	for key, a := range rules {
+		if err := m.Put(key, uint8(a)); err != nil {
+			return policyErr.Throwf("%v", err)
+		}
+	}
+
+	return nil
+}
+
+// bind writes the rules to the policy maps of the loaded module and returns the enforcement programs.
+func (p *Policy) bind(objs *tarianObjects) ([]*ebpf.ProgramInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := writeRules(execRules(&objs.tarianMaps), p.exec); err != nil {
+		return nil, err
+	}
+
+	if err := writeRules(openRules(&objs.tarianMaps), p.open); err != nil {
+		return nil, err
+	}
+
+	if err := writeRules(connectRules(&objs.tarianMaps), p.connect); err != nil {
+		return nil, err
+	}
+
+	p.maps = &objs.tarianMaps
+
+	var progs []*ebpf.ProgramInfo
+	for _, lh := range lsmHooks {
+		progs = append(progs, ebpf.NewProgram(lh.program(&objs.tarianPrograms), ebpf.NewHookInfo().LSM(lh.hook)))
+	}
+
+	return progs, nil
+}
+
+// mountInfoPath lists the mounts of the mount namespace of the detector with the device of their super block.
+var mountInfoPath = "/proc/self/mountinfo"
+
+// unsupportedFileSystems lists the file systems whose files can not be matched by the file rules: the
+// inode seen by the LSM hooks is not the one reported to userspace.
+var unsupportedFileSystems = map[string]bool{
+	"overlay": true,
+}
+
+// newFileRuleKey returns the key of the rules matching the file at path: its inode and the device of
+// the super block of its mount, which the LSM programs read from the file. The device is taken from the
+// mount table rather than stat, which reports another device on btrfs subvolumes.
+func newFileRuleKey(path string) (fileRuleKey, error) {
+	var stx unix.Statx_t
+	if err := unix.Statx(unix.AT_FDCWD, path, 0, unix.STATX_INO|unix.STATX_MNT_ID, &stx); err != nil {
+		return fileRuleKey{}, policyErr.Throwf("%s: %v", path, err)
+	}
+
+	mntID := stx.Mnt_id
+	if stx.Mask&unix.STATX_MNT_ID == 0 {
+		// Kernels before 5.8 only report the mount through the file handles
+		_, id, err := unix.NameToHandleAt(unix.AT_FDCWD, path, 0)
+		if err != nil {
+			return fileRuleKey{}, policyErr.Throwf("%s: no mount id: %v", path, err)
+		}
+
+		mntID = uint64(id)
+	}
+
+	f, err := os.Open(mountInfoPath)
+	if err != nil {
+		return fileRuleKey{}, policyErr.Throwf("%v", err)
+	}
+	defer f.Close()
+
+	dev, fsType, err := parseMountInfo(f, mntID)
+	if err != nil {
+		return fileRuleKey{}, policyErr.Throwf("%s: %v", path, err)
+	}
+
+	if unsupportedFileSystems[fsType] {
+		return fileRuleKey{}, policyErr.Throwf("%s: files on %s file systems can not be matched", path, fsType)
+	}
+
+	return fileRuleKey{Ino: stx.Ino, Dev: dev}, nil
+}
+
+// parseMountInfo returns the device, in the kernel encoding, and the file system type of the mount
+// with the given id in a mount table formatted as /proc/self/mountinfo.
+func parseMountInfo(r io.Reader, mntID uint64) (uint32, string, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// id parent major:minor root mount-point options [optional fields...] - type source super-options
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != strconv.FormatUint(mntID, 10) {
+			continue
+		}
+
+		sep := slices.Index(fields, "-")
+		if sep < 0 || sep+1 >= len(fields) {
+			return 0, "", policyErr.Throwf("malformed mount %d", mntID)
+		}
+
+		var major, minor uint32
+		if _, err := fmt.Sscanf(fields[2], "%d:%d", &major, &minor); err != nil {
+			return 0, "", policyErr.Throwf("malformed device of mount %d: %v", mntID, err)
+		}
+
+		return major<<20 | minor, fields[sep+1], nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, "", policyErr.Throwf("%v", err)
+	}
+
+	return 0, "", policyErr.Throwf("mount %d not found", mntID)
+}
+
+// newConnectRuleKey returns the key of the rules matching the connections to the address and port.
+func newConnectRuleKey(ip net.IP, port uint16) (connectRuleKey, error) {
+	key := connectRuleKey{Port: port}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		key.Family = unix.AF_INET
+		copy(key.Addr[:], ip4)
+		return key, nil
+	}
+
+	if ip16 := ip.To16(); ip16 != nil {
+		key.Family = unix.AF_INET6
+		copy(key.Addr[:], ip16)
+		return key, nil
+	}
+
+	return key, policyErr.Throwf("invalid address %v", ip)
+}
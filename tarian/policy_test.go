@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// TestPolicy tests adding and removing the rules of a policy that is not bound to a module
+func TestPolicy(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing")
+	p := NewPolicy()
+
+	tests := []struct {
+		name    string
+		apply   func() error
+		want    int
+		wantErr bool
+	}{
+		{name: "deny exec", apply: func() error { return p.AddExecRule(file, Deny) }, want: 1},
+		{name: "audit open", apply: func() error { return p.AddOpenRule(file, Audit) }, want: 2},
+		{name: "replace open action", apply: func() error { return p.AddOpenRule(file, Deny) }, want: 2},
+		{name: "deny connect", apply: func() error { return p.AddConnectRule(net.ParseIP("10.0.0.1"), 443, Deny) }, want: 3},
+		{name: "unknown action", apply: func() error { return p.AddExecRule(file, Action(7)) }, want: 3, wantErr: true},
+		{name: "missing file", apply: func() error { return p.AddExecRule(missing, Deny) }, want: 3, wantErr: true},
+		{name: "remove exec", apply: func() error { return p.RemoveExecRule(file) }, want: 2},
+		{name: "remove missing exec rule", apply: func() error { return p.RemoveExecRule(file) }, want: 2, wantErr: true},
+		{name: "remove open", apply: func() error { return p.RemoveOpenRule(file) }, want: 1},
+		{name: "remove connect on another port", apply: func() error { return p.RemoveConnectRule(net.ParseIP("10.0.0.1"), 80) }, want: 1, wantErr: true},
+		{name: "remove connect", apply: func() error { return p.RemoveConnectRule(net.ParseIP("10.0.0.1"), 443) }, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.apply()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Policy error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if p.Len() != tt.want {
+				t.Errorf("Policy.Len() = %v, want %v", p.Len(), tt.want)
+			}
+		})
+	}
+}
+
+// TestNewFileRuleKey tests the newFileRuleKey function
+func TestNewFileRuleKey(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stx unix.Statx_t
+	if err := unix.Statx(unix.AT_FDCWD, file, 0, unix.STATX_INO|unix.STATX_MNT_ID, &stx); err != nil {
+		t.Fatal(err)
+	}
+
+	if stx.Mask&unix.STATX_MNT_ID == 0 {
+		t.Skip("statx does not report the mount id")
+	}
+
+	mountInfo := func(fsType string) string {
+		path := filepath.Join(t.TempDir(), "mountinfo")
+		table := fmt.Sprintf("1 0 254:0 / / rw - ext4 /dev/vda rw\n%d 1 0:45 / /data rw shared:7 - %s /dev/vdb rw\n", stx.Mnt_id, fsType)
+		if err := os.WriteFile(path, []byte(table), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	tests := []struct {
+		name      string
+		mountInfo string
+		want      fileRuleKey
+		wantErr   bool
+	}{
+		{name: "running mount table", mountInfo: mountInfoPath, want: fileRuleKey{Ino: stx.Ino, Dev: stx.Dev_major<<20 | stx.Dev_minor}},
+		{name: "device of the super block", mountInfo: mountInfo("btrfs"), want: fileRuleKey{Ino: stx.Ino, Dev: 45}},
+		{name: "overlay", mountInfo: mountInfo("overlay"), wantErr: true},
+		{name: "missing mount table", mountInfo: filepath.Join(t.TempDir(), "missing"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := mountInfoPath
+			t.Cleanup(func() { mountInfoPath = path })
+			mountInfoPath = tt.mountInfo
+
+			got, err := newFileRuleKey(file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newFileRuleKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("newFileRuleKey() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseMountInfo tests the parseMountInfo function
+func Test_parseMountInfo(t *testing.T) {
+	const table = `28 1 254:0 / / rw,relatime - ext4 /dev/vda rw
+45 28 0:52 /@home /home rw,relatime shared:1 master:2 - btrfs /dev/sda2 rw,subvolid=257
+46 28 0:53 / /var/lib/docker/overlay2/merged rw - overlay overlay rw,lowerdir=/l
+47 28 0:54 / /broken rw shared:3
+`
+
+	tests := []struct {
+		name     string
+		id       uint64
+		wantDev  uint32
+		wantType string
+		wantErr  bool
+	}{
+		{name: "block device", id: 28, wantDev: 254<<20 | 0, wantType: "ext4"},
+		{name: "optional fields", id: 45, wantDev: 52, wantType: "btrfs"},
+		{name: "overlay", id: 46, wantDev: 53, wantType: "overlay"},
+		{name: "missing separator", id: 47, wantErr: true},
+		{name: "missing mount", id: 99, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev, fsType, err := parseMountInfo(strings.NewReader(table), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMountInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if dev != tt.wantDev || fsType != tt.wantType {
+				t.Errorf("parseMountInfo() = %v, %v, want %v, %v", dev, fsType, tt.wantDev, tt.wantType)
+			}
+		})
+	}
+}
+
+// TestNewConnectRuleKey tests the newConnectRuleKey function
+func TestNewConnectRuleKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      net.IP
+		want    connectRuleKey
+		wantErr bool
+	}{
+		{
+			name: "ipv4",
+			ip:   net.ParseIP("192.168.1.2"),
+			want: connectRuleKey{Addr: [16]byte{192, 168, 1, 2}, Port: 53, Family: unix.AF_INET},
+		},
+		{
+			name: "ipv6",
+			ip:   net.ParseIP("2001:db8::1"),
+			want: connectRuleKey{Addr: [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}, Port: 53, Family: unix.AF_INET6},
+		},
+		{
+			name:    "invalid address",
+			ip:      net.IP{1, 2, 3},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newConnectRuleKey(tt.ip, 53)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newConnectRuleKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("newConnectRuleKey() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAction tests the names of the actions
+func TestAction(t *testing.T) {
+	for a, want := range map[Action]string{Deny: "deny", Audit: "audit", Action(0): "unknown"} {
+		if a.String() != want {
+			t.Errorf("Action.String() = %v, want %v", a.String(), want)
+		}
+	}
+}
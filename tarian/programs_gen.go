@@ -0,0 +1,557 @@
+// Code generated by eventgen from events.json; DO NOT EDIT.
+
+package tarian
+
+import "github.com/cilium/ebpf"
+
+// syscallProbes registers the probes of every syscall declared in events.json.
+var syscallProbes = []probeDescriptor{
+	{name: "execve", arches: []string{"amd64", "arm64"}, entry: "tdf_execve_e", exit: "tdf_execve_r", tpEntry: "tdf_execve_te", tpExit: "tdf_execve_tr"},
+	{name: "execveat", arches: []string{"amd64", "arm64"}, entry: "tdf_execveat_e", exit: "tdf_execveat_r", tpEntry: "tdf_execveat_te", tpExit: "tdf_execveat_tr"},
+	{name: "clone", arches: []string{"amd64", "arm64"}, entry: "tdf_clone_e", exit: "tdf_clone_r", tpEntry: "tdf_clone_te", tpExit: "tdf_clone_tr"},
+	{name: "close", arches: []string{"amd64", "arm64"}, entry: "tdf_close_e", exit: "tdf_close_r", tpEntry: "tdf_close_te", tpExit: "tdf_close_tr"},
+	{name: "read", arches: []string{"amd64", "arm64"}, entry: "tdf_read_e", exit: "tdf_read_r", tpEntry: "tdf_read_te", tpExit: "tdf_read_tr"},
+	{name: "write", arches: []string{"amd64", "arm64"}, entry: "tdf_write_e", exit: "tdf_write_r", tpEntry: "tdf_write_te", tpExit: "tdf_write_tr"},
+	{name: "open", arches: []string{"amd64"}, entry: "tdf_open_e", exit: "tdf_open_r", tpEntry: "tdf_open_te", tpExit: "tdf_open_tr"},
+	{name: "readv", arches: []string{"amd64", "arm64"}, entry: "tdf_readv_e", exit: "tdf_readv_r", tpEntry: "tdf_readv_te", tpExit: "tdf_readv_tr"},
+	{name: "writev", arches: []string{"amd64", "arm64"}, entry: "tdf_writev_e", exit: "tdf_writev_r", tpEntry: "tdf_writev_te", tpExit: "tdf_writev_tr"},
+	{name: "openat", arches: []string{"amd64", "arm64"}, entry: "tdf_openat_e", exit: "tdf_openat_r", tpEntry: "tdf_openat_te", tpExit: "tdf_openat_tr"},
+	{name: "openat2", arches: []string{"amd64", "arm64"}, entry: "tdf_openat2_e", exit: "tdf_openat2_r", tpEntry: "tdf_openat2_te", tpExit: "tdf_openat2_tr"},
+	{name: "listen", arches: []string{"amd64", "arm64"}, entry: "tdf_listen_e", exit: "tdf_listen_r", tpEntry: "tdf_listen_te", tpExit: "tdf_listen_tr"},
+	{name: "socket", arches: []string{"amd64", "arm64"}, entry: "tdf_socket_e", exit: "tdf_socket_r", tpEntry: "tdf_socket_te", tpExit: "tdf_socket_tr"},
+	{name: "accept", arches: []string{"amd64", "arm64"}, entry: "tdf_accept_e", exit: "tdf_accept_r", tpEntry: "tdf_accept_te", tpExit: "tdf_accept_tr"},
+	{name: "bind", arches: []string{"amd64", "arm64"}, entry: "tdf_bind_e", exit: "tdf_bind_r", tpEntry: "tdf_bind_te", tpExit: "tdf_bind_tr"},
+	{name: "connect", arches: []string{"amd64", "arm64"}, entry: "tdf_connect_e", exit: "tdf_connect_r", tpEntry: "tdf_connect_te", tpExit: "tdf_connect_tr"},
+	{name: "unlink", arches: []string{"amd64"}, entry: "tdf_unlink_e", exit: "tdf_unlink_r", tpEntry: "tdf_unlink_te", tpExit: "tdf_unlink_tr"},
+	{name: "unlinkat", arches: []string{"amd64", "arm64"}, entry: "tdf_unlinkat_e", exit: "tdf_unlinkat_r", tpEntry: "tdf_unlinkat_te", tpExit: "tdf_unlinkat_tr"},
+	{name: "rename", arches: []string{"amd64"}, entry: "tdf_rename_e", exit: "tdf_rename_r", tpEntry: "tdf_rename_te", tpExit: "tdf_rename_tr"},
+	{name: "renameat2", arches: []string{"amd64", "arm64"}, entry: "tdf_renameat2_e", exit: "tdf_renameat2_r", tpEntry: "tdf_renameat2_te", tpExit: "tdf_renameat2_tr"},
+	{name: "chmod", arches: []string{"amd64"}, entry: "tdf_chmod_e", exit: "tdf_chmod_r", tpEntry: "tdf_chmod_te", tpExit: "tdf_chmod_tr"},
+	{name: "fchmodat", arches: []string{"amd64", "arm64"}, entry: "tdf_fchmodat_e", exit: "tdf_fchmodat_r", tpEntry: "tdf_fchmodat_te", tpExit: "tdf_fchmodat_tr"},
+	{name: "chown", arches: []string{"amd64"}, entry: "tdf_chown_e", exit: "tdf_chown_r", tpEntry: "tdf_chown_te", tpExit: "tdf_chown_tr"},
+	{name: "fchownat", arches: []string{"amd64", "arm64"}, entry: "tdf_fchownat_e", exit: "tdf_fchownat_r", tpEntry: "tdf_fchownat_te", tpExit: "tdf_fchownat_tr"},
+	{name: "truncate", arches: []string{"amd64", "arm64"}, entry: "tdf_truncate_e", exit: "tdf_truncate_r", tpEntry: "tdf_truncate_te", tpExit: "tdf_truncate_tr"},
+	{name: "ftruncate", arches: []string{"amd64", "arm64"}, entry: "tdf_ftruncate_e", exit: "tdf_ftruncate_r", tpEntry: "tdf_ftruncate_te", tpExit: "tdf_ftruncate_tr"},
+	{name: "link", arches: []string{"amd64"}, entry: "tdf_link_e", exit: "tdf_link_r", tpEntry: "tdf_link_te", tpExit: "tdf_link_tr"},
+	{name: "linkat", arches: []string{"amd64", "arm64"}, entry: "tdf_linkat_e", exit: "tdf_linkat_r", tpEntry: "tdf_linkat_te", tpExit: "tdf_linkat_tr"},
+	{name: "symlink", arches: []string{"amd64"}, entry: "tdf_symlink_e", exit: "tdf_symlink_r", tpEntry: "tdf_symlink_te", tpExit: "tdf_symlink_tr"},
+	{name: "symlinkat", arches: []string{"amd64", "arm64"}, entry: "tdf_symlinkat_e", exit: "tdf_symlinkat_r", tpEntry: "tdf_symlinkat_te", tpExit: "tdf_symlinkat_tr"},
+	{name: "setuid", arches: []string{"amd64", "arm64"}, entry: "tdf_setuid_e", exit: "tdf_setuid_r", tpEntry: "tdf_setuid_te", tpExit: "tdf_setuid_tr"},
+	{name: "setgid", arches: []string{"amd64", "arm64"}, entry: "tdf_setgid_e", exit: "tdf_setgid_r", tpEntry: "tdf_setgid_te", tpExit: "tdf_setgid_tr"},
+	{name: "setreuid", arches: []string{"amd64", "arm64"}, entry: "tdf_setreuid_e", exit: "tdf_setreuid_r", tpEntry: "tdf_setreuid_te", tpExit: "tdf_setreuid_tr"},
+	{name: "setresuid", arches: []string{"amd64", "arm64"}, entry: "tdf_setresuid_e", exit: "tdf_setresuid_r", tpEntry: "tdf_setresuid_te", tpExit: "tdf_setresuid_tr"},
+	{name: "setresgid", arches: []string{"amd64", "arm64"}, entry: "tdf_setresgid_e", exit: "tdf_setresgid_r", tpEntry: "tdf_setresgid_te", tpExit: "tdf_setresgid_tr"},
+	{name: "setfsuid", arches: []string{"amd64", "arm64"}, entry: "tdf_setfsuid_e", exit: "tdf_setfsuid_r", tpEntry: "tdf_setfsuid_te", tpExit: "tdf_setfsuid_tr"},
+	{name: "capset", arches: []string{"amd64", "arm64"}, entry: "tdf_capset_e", exit: "tdf_capset_r", tpEntry: "tdf_capset_te", tpExit: "tdf_capset_tr"},
+	{name: "setns", arches: []string{"amd64", "arm64"}, entry: "tdf_setns_e", exit: "tdf_setns_r", tpEntry: "tdf_setns_te", tpExit: "tdf_setns_tr"},
+	{name: "unshare", arches: []string{"amd64", "arm64"}, entry: "tdf_unshare_e", exit: "tdf_unshare_r", tpEntry: "tdf_unshare_te", tpExit: "tdf_unshare_tr"},
+	{name: "mount", arches: []string{"amd64", "arm64"}, entry: "tdf_mount_e", exit: "tdf_mount_r", tpEntry: "tdf_mount_te", tpExit: "tdf_mount_tr"},
+	{name: "umount", arches: []string{"amd64", "arm64"}, entry: "tdf_umount_e", exit: "tdf_umount_r", tpEntry: "tdf_umount_te", tpExit: "tdf_umount_tr"},
+	{name: "pivot_root", arches: []string{"amd64", "arm64"}, entry: "tdf_pivot_root_e", exit: "tdf_pivot_root_r", tpEntry: "tdf_pivot_root_te", tpExit: "tdf_pivot_root_tr"},
+	{name: "chroot", arches: []string{"amd64", "arm64"}, entry: "tdf_chroot_e", exit: "tdf_chroot_r", tpEntry: "tdf_chroot_te", tpExit: "tdf_chroot_tr"},
+	{name: "init_module", arches: []string{"amd64", "arm64"}, entry: "tdf_init_module_e", exit: "tdf_init_module_r", tpEntry: "tdf_init_module_te", tpExit: "tdf_init_module_tr"},
+	{name: "finit_module", arches: []string{"amd64", "arm64"}, entry: "tdf_finit_module_e", exit: "tdf_finit_module_r", tpEntry: "tdf_finit_module_te", tpExit: "tdf_finit_module_tr"},
+	{name: "delete_module", arches: []string{"amd64", "arm64"}, entry: "tdf_delete_module_e", exit: "tdf_delete_module_r", tpEntry: "tdf_delete_module_te", tpExit: "tdf_delete_module_tr"},
+	{name: "bpf", arches: []string{"amd64", "arm64"}, entry: "tdf_bpf_e", exit: "tdf_bpf_r", tpEntry: "tdf_bpf_te", tpExit: "tdf_bpf_tr"},
+	{name: "ptrace", arches: []string{"amd64", "arm64"}, entry: "tdf_ptrace_e", exit: "tdf_ptrace_r", tpEntry: "tdf_ptrace_te", tpExit: "tdf_ptrace_tr"},
+	{name: "process_vm_readv", arches: []string{"amd64", "arm64"}, entry: "tdf_process_vm_readv_e", exit: "tdf_process_vm_readv_r", tpEntry: "tdf_process_vm_readv_te", tpExit: "tdf_process_vm_readv_tr"},
+	{name: "process_vm_writev", arches: []string{"amd64", "arm64"}, entry: "tdf_process_vm_writev_e", exit: "tdf_process_vm_writev_r", tpEntry: "tdf_process_vm_writev_te", tpExit: "tdf_process_vm_writev_tr"},
+	{name: "memfd_create", arches: []string{"amd64", "arm64"}, entry: "tdf_memfd_create_e", exit: "tdf_memfd_create_r", tpEntry: "tdf_memfd_create_te", tpExit: "tdf_memfd_create_tr"},
+	{name: "mmap", arches: []string{"amd64", "arm64"}, entry: "tdf_mmap_e", exit: "tdf_mmap_r", tpEntry: "tdf_mmap_te", tpExit: "tdf_mmap_tr"},
+	{name: "mprotect", arches: []string{"amd64", "arm64"}, entry: "tdf_mprotect_e", exit: "tdf_mprotect_r", tpEntry: "tdf_mprotect_te", tpExit: "tdf_mprotect_tr"},
+	{name: "clone3", arches: []string{"amd64", "arm64"}, entry: "tdf_clone3_e", exit: "tdf_clone3_r", tpEntry: "tdf_clone3_te", tpExit: "tdf_clone3_tr"},
+	{name: "fork", arches: []string{"amd64"}, entry: "tdf_fork_e", exit: "tdf_fork_r", tpEntry: "tdf_fork_te", tpExit: "tdf_fork_tr"},
+	{name: "vfork", arches: []string{"amd64"}, entry: "tdf_vfork_e", exit: "tdf_vfork_r", tpEntry: "tdf_vfork_te", tpExit: "tdf_vfork_tr"},
+	{name: "sendto", arches: []string{"amd64", "arm64"}, optional: true, entry: "tdf_sendto_e", exit: "tdf_sendto_r", tpEntry: "tdf_sendto_te", tpExit: "tdf_sendto_tr"},
+	{name: "recvfrom", arches: []string{"amd64", "arm64"}, optional: true, entry: "tdf_recvfrom_e", exit: "tdf_recvfrom_r", tpEntry: "tdf_recvfrom_te", tpExit: "tdf_recvfrom_tr"},
+	{name: "sendmsg", arches: []string{"amd64", "arm64"}, optional: true, entry: "tdf_sendmsg_e", exit: "tdf_sendmsg_r", tpEntry: "tdf_sendmsg_te", tpExit: "tdf_sendmsg_tr"},
+	{name: "recvmsg", arches: []string{"amd64", "arm64"}, optional: true, entry: "tdf_recvmsg_e", exit: "tdf_recvmsg_r", tpEntry: "tdf_recvmsg_te", tpExit: "tdf_recvmsg_tr"},
+}
+
+// program returns the loaded program with the given name or nil if there is none.
+func (p *tarianPrograms) program(name string) *ebpf.Program {
+	switch name {
+	case "tdf_execve_e":
+		return p.TdfExecveE
+	case "tdf_execve_r":
+		return p.TdfExecveR
+	case "tdf_execve_te":
+		return p.TdfExecveTe
+	case "tdf_execve_tr":
+		return p.TdfExecveTr
+	case "tdf_execveat_e":
+		return p.TdfExecveatE
+	case "tdf_execveat_r":
+		return p.TdfExecveatR
+	case "tdf_execveat_te":
+		return p.TdfExecveatTe
+	case "tdf_execveat_tr":
+		return p.TdfExecveatTr
+	case "tdf_clone_e":
+		return p.TdfCloneE
+	case "tdf_clone_r":
+		return p.TdfCloneR
+	case "tdf_clone_te":
+		return p.TdfCloneTe
+	case "tdf_clone_tr":
+		return p.TdfCloneTr
+	case "tdf_close_e":
+		return p.TdfCloseE
+	case "tdf_close_r":
+		return p.TdfCloseR
+	case "tdf_close_te":
+		return p.TdfCloseTe
+	case "tdf_close_tr":
+		return p.TdfCloseTr
+	case "tdf_read_e":
+		return p.TdfReadE
+	case "tdf_read_r":
+		return p.TdfReadR
+	case "tdf_read_te":
+		return p.TdfReadTe
+	case "tdf_read_tr":
+		return p.TdfReadTr
+	case "tdf_write_e":
+		return p.TdfWriteE
+	case "tdf_write_r":
+		return p.TdfWriteR
+	case "tdf_write_te":
+		return p.TdfWriteTe
+	case "tdf_write_tr":
+		return p.TdfWriteTr
+	case "tdf_open_e":
+		return p.TdfOpenE
+	case "tdf_open_r":
+		return p.TdfOpenR
+	case "tdf_open_te":
+		return p.TdfOpenTe
+	case "tdf_open_tr":
+		return p.TdfOpenTr
+	case "tdf_readv_e":
+		return p.TdfReadvE
+	case "tdf_readv_r":
+		return p.TdfReadvR
+	case "tdf_readv_te":
+		return p.TdfReadvTe
+	case "tdf_readv_tr":
+		return p.TdfReadvTr
+	case "tdf_writev_e":
+		return p.TdfWritevE
+	case "tdf_writev_r":
+		return p.TdfWritevR
+	case "tdf_writev_te":
+		return p.TdfWritevTe
+	case "tdf_writev_tr":
+		return p.TdfWritevTr
+	case "tdf_openat_e":
+		return p.TdfOpenatE
+	case "tdf_openat_r":
+		return p.TdfOpenatR
+	case "tdf_openat_te":
+		return p.TdfOpenatTe
+	case "tdf_openat_tr":
+		return p.TdfOpenatTr
+	case "tdf_openat2_e":
+		return p.TdfOpenat2E
+	case "tdf_openat2_r":
+		return p.TdfOpenat2R
+	case "tdf_openat2_te":
+		return p.TdfOpenat2Te
+	case "tdf_openat2_tr":
+		return p.TdfOpenat2Tr
+	case "tdf_listen_e":
+		return p.TdfListenE
+	case "tdf_listen_r":
+		return p.TdfListenR
+	case "tdf_listen_te":
+		return p.TdfListenTe
+	case "tdf_listen_tr":
+		return p.TdfListenTr
+	case "tdf_socket_e":
+		return p.TdfSocketE
+	case "tdf_socket_r":
+		return p.TdfSocketR
+	case "tdf_socket_te":
+		return p.TdfSocketTe
+	case "tdf_socket_tr":
+		return p.TdfSocketTr
+	case "tdf_accept_e":
+		return p.TdfAcceptE
+	case "tdf_accept_r":
+		return p.TdfAcceptR
+	case "tdf_accept_te":
+		return p.TdfAcceptTe
+	case "tdf_accept_tr":
+		return p.TdfAcceptTr
+	case "tdf_bind_e":
+		return p.TdfBindE
+	case "tdf_bind_r":
+		return p.TdfBindR
+	case "tdf_bind_te":
+		return p.TdfBindTe
+	case "tdf_bind_tr":
+		return p.TdfBindTr
+	case "tdf_connect_e":
+		return p.TdfConnectE
+	case "tdf_connect_r":
+		return p.TdfConnectR
+	case "tdf_connect_te":
+		return p.TdfConnectTe
+	case "tdf_connect_tr":
+		return p.TdfConnectTr
+	case "tdf_unlink_e":
+		return p.TdfUnlinkE
+	case "tdf_unlink_r":
+		return p.TdfUnlinkR
+	case "tdf_unlink_te":
+		return p.TdfUnlinkTe
+	case "tdf_unlink_tr":
+		return p.TdfUnlinkTr
+	case "tdf_unlinkat_e":
+		return p.TdfUnlinkatE
+	case "tdf_unlinkat_r":
+		return p.TdfUnlinkatR
+	case "tdf_unlinkat_te":
+		return p.TdfUnlinkatTe
+	case "tdf_unlinkat_tr":
+		return p.TdfUnlinkatTr
+	case "tdf_rename_e":
+		return p.TdfRenameE
+	case "tdf_rename_r":
+		return p.TdfRenameR
+	case "tdf_rename_te":
+		return p.TdfRenameTe
+	case "tdf_rename_tr":
+		return p.TdfRenameTr
+	case "tdf_renameat2_e":
+		return p.TdfRenameat2E
+	case "tdf_renameat2_r":
+		return p.TdfRenameat2R
+	case "tdf_renameat2_te":
+		return p.TdfRenameat2Te
+	case "tdf_renameat2_tr":
+		return p.TdfRenameat2Tr
+	case "tdf_chmod_e":
+		return p.TdfChmodE
+	case "tdf_chmod_r":
+		return p.TdfChmodR
+	case "tdf_chmod_te":
+		return p.TdfChmodTe
+	case "tdf_chmod_tr":
+		return p.TdfChmodTr
+	case "tdf_fchmodat_e":
+		return p.TdfFchmodatE
+	case "tdf_fchmodat_r":
+		return p.TdfFchmodatR
+	case "tdf_fchmodat_te":
+		return p.TdfFchmodatTe
+	case "tdf_fchmodat_tr":
+		return p.TdfFchmodatTr
+	case "tdf_chown_e":
+		return p.TdfChownE
+	case "tdf_chown_r":
+		return p.TdfChownR
+	case "tdf_chown_te":
+		return p.TdfChownTe
+	case "tdf_chown_tr":
+		return p.TdfChownTr
+	case "tdf_fchownat_e":
+		return p.TdfFchownatE
+	case "tdf_fchownat_r":
+		return p.TdfFchownatR
+	case "tdf_fchownat_te":
+		return p.TdfFchownatTe
+	case "tdf_fchownat_tr":
+		return p.TdfFchownatTr
+	case "tdf_truncate_e":
+		return p.TdfTruncateE
+	case "tdf_truncate_r":
+		return p.TdfTruncateR
+	case "tdf_truncate_te":
+		return p.TdfTruncateTe
+	case "tdf_truncate_tr":
+		return p.TdfTruncateTr
+	case "tdf_ftruncate_e":
+		return p.TdfFtruncateE
+	case "tdf_ftruncate_r":
+		return p.TdfFtruncateR
+	case "tdf_ftruncate_te":
+		return p.TdfFtruncateTe
+	case "tdf_ftruncate_tr":
+		return p.TdfFtruncateTr
+	case "tdf_link_e":
+		return p.TdfLinkE
+	case "tdf_link_r":
+		return p.TdfLinkR
+	case "tdf_link_te":
+		return p.TdfLinkTe
+	case "tdf_link_tr":
+		return p.TdfLinkTr
+	case "tdf_linkat_e":
+		return p.TdfLinkatE
+	case "tdf_linkat_r":
+		return p.TdfLinkatR
+	case "tdf_linkat_te":
+		return p.TdfLinkatTe
+	case "tdf_linkat_tr":
+		return p.TdfLinkatTr
+	case "tdf_symlink_e":
+		return p.TdfSymlinkE
+	case "tdf_symlink_r":
+		return p.TdfSymlinkR
+	case "tdf_symlink_te":
+		return p.TdfSymlinkTe
+	case "tdf_symlink_tr":
+		return p.TdfSymlinkTr
+	case "tdf_symlinkat_e":
+		return p.TdfSymlinkatE
+	case "tdf_symlinkat_r":
+		return p.TdfSymlinkatR
+	case "tdf_symlinkat_te":
+		return p.TdfSymlinkatTe
+	case "tdf_symlinkat_tr":
+		return p.TdfSymlinkatTr
+	case "tdf_setuid_e":
+		return p.TdfSetuidE
+	case "tdf_setuid_r":
+		return p.TdfSetuidR
+	case "tdf_setuid_te":
+		return p.TdfSetuidTe
+	case "tdf_setuid_tr":
+		return p.TdfSetuidTr
+	case "tdf_setgid_e":
+		return p.TdfSetgidE
+	case "tdf_setgid_r":
+		return p.TdfSetgidR
+	case "tdf_setgid_te":
+		return p.TdfSetgidTe
+	case "tdf_setgid_tr":
+		return p.TdfSetgidTr
+	case "tdf_setreuid_e":
+		return p.TdfSetreuidE
+	case "tdf_setreuid_r":
+		return p.TdfSetreuidR
+	case "tdf_setreuid_te":
+		return p.TdfSetreuidTe
+	case "tdf_setreuid_tr":
+		return p.TdfSetreuidTr
+	case "tdf_setresuid_e":
+		return p.TdfSetresuidE
+	case "tdf_setresuid_r":
+		return p.TdfSetresuidR
+	case "tdf_setresuid_te":
+		return p.TdfSetresuidTe
+	case "tdf_setresuid_tr":
+		return p.TdfSetresuidTr
+	case "tdf_setresgid_e":
+		return p.TdfSetresgidE
+	case "tdf_setresgid_r":
+		return p.TdfSetresgidR
+	case "tdf_setresgid_te":
+		return p.TdfSetresgidTe
+	case "tdf_setresgid_tr":
+		return p.TdfSetresgidTr
+	case "tdf_setfsuid_e":
+		return p.TdfSetfsuidE
+	case "tdf_setfsuid_r":
+		return p.TdfSetfsuidR
+	case "tdf_setfsuid_te":
+		return p.TdfSetfsuidTe
+	case "tdf_setfsuid_tr":
+		return p.TdfSetfsuidTr
+	case "tdf_capset_e":
+		return p.TdfCapsetE
+	case "tdf_capset_r":
+		return p.TdfCapsetR
+	case "tdf_capset_te":
+		return p.TdfCapsetTe
+	case "tdf_capset_tr":
+		return p.TdfCapsetTr
+	case "tdf_setns_e":
+		return p.TdfSetnsE
+	case "tdf_setns_r":
+		return p.TdfSetnsR
+	case "tdf_setns_te":
+		return p.TdfSetnsTe
+	case "tdf_setns_tr":
+		return p.TdfSetnsTr
+	case "tdf_unshare_e":
+		return p.TdfUnshareE
+	case "tdf_unshare_r":
+		return p.TdfUnshareR
+	case "tdf_unshare_te":
+		return p.TdfUnshareTe
+	case "tdf_unshare_tr":
+		return p.TdfUnshareTr
+	case "tdf_mount_e":
+		return p.TdfMountE
+	case "tdf_mount_r":
+		return p.TdfMountR
+	case "tdf_mount_te":
+		return p.TdfMountTe
+	case "tdf_mount_tr":
+		return p.TdfMountTr
+	case "tdf_umount_e":
+		return p.TdfUmountE
+	case "tdf_umount_r":
+		return p.TdfUmountR
+	case "tdf_umount_te":
+		return p.TdfUmountTe
+	case "tdf_umount_tr":
+		return p.TdfUmountTr
+	case "tdf_pivot_root_e":
+		return p.TdfPivotRootE
+	case "tdf_pivot_root_r":
+		return p.TdfPivotRootR
+	case "tdf_pivot_root_te":
+		return p.TdfPivotRootTe
+	case "tdf_pivot_root_tr":
+		return p.TdfPivotRootTr
+	case "tdf_chroot_e":
+		return p.TdfChrootE
+	case "tdf_chroot_r":
+		return p.TdfChrootR
+	case "tdf_chroot_te":
+		return p.TdfChrootTe
+	case "tdf_chroot_tr":
+		return p.TdfChrootTr
+	case "tdf_init_module_e":
+		return p.TdfInitModuleE
+	case "tdf_init_module_r":
+		return p.TdfInitModuleR
+	case "tdf_init_module_te":
+		return p.TdfInitModuleTe
+	case "tdf_init_module_tr":
+		return p.TdfInitModuleTr
+	case "tdf_finit_module_e":
+		return p.TdfFinitModuleE
+	case "tdf_finit_module_r":
+		return p.TdfFinitModuleR
+	case "tdf_finit_module_te":
+		return p.TdfFinitModuleTe
+	case "tdf_finit_module_tr":
+		return p.TdfFinitModuleTr
+	case "tdf_delete_module_e":
+		return p.TdfDeleteModuleE
+	case "tdf_delete_module_r":
+		return p.TdfDeleteModuleR
+	case "tdf_delete_module_te":
+		return p.TdfDeleteModuleTe
+	case "tdf_delete_module_tr":
+		return p.TdfDeleteModuleTr
+	case "tdf_bpf_e":
+		return p.TdfBpfE
+	case "tdf_bpf_r":
+		return p.TdfBpfR
+	case "tdf_bpf_te":
+		return p.TdfBpfTe
+	case "tdf_bpf_tr":
+		return p.TdfBpfTr
+	case "tdf_ptrace_e":
+		return p.TdfPtraceE
+	case "tdf_ptrace_r":
+		return p.TdfPtraceR
+	case "tdf_ptrace_te":
+		return p.TdfPtraceTe
+	case "tdf_ptrace_tr":
+		return p.TdfPtraceTr
+	case "tdf_process_vm_readv_e":
+		return p.TdfProcessVmReadvE
+	case "tdf_process_vm_readv_r":
+		return p.TdfProcessVmReadvR
+	case "tdf_process_vm_readv_te":
+		return p.TdfProcessVmReadvTe
+	case "tdf_process_vm_readv_tr":
+		return p.TdfProcessVmReadvTr
+	case "tdf_process_vm_writev_e":
+		return p.TdfProcessVmWritevE
+	case "tdf_process_vm_writev_r":
+		return p.TdfProcessVmWritevR
+	case "tdf_process_vm_writev_te":
+		return p.TdfProcessVmWritevTe
+	case "tdf_process_vm_writev_tr":
+		return p.TdfProcessVmWritevTr
+	case "tdf_memfd_create_e":
+		return p.TdfMemfdCreateE
+	case "tdf_memfd_create_r":
+		return p.TdfMemfdCreateR
+	case "tdf_memfd_create_te":
+		return p.TdfMemfdCreateTe
+	case "tdf_memfd_create_tr":
+		return p.TdfMemfdCreateTr
+	case "tdf_mmap_e":
+		return p.TdfMmapE
+	case "tdf_mmap_r":
+		return p.TdfMmapR
+	case "tdf_mmap_te":
+		return p.TdfMmapTe
+	case "tdf_mmap_tr":
+		return p.TdfMmapTr
+	case "tdf_mprotect_e":
+		return p.TdfMprotectE
+	case "tdf_mprotect_r":
+		return p.TdfMprotectR
+	case "tdf_mprotect_te":
+		return p.TdfMprotectTe
+	case "tdf_mprotect_tr":
+		return p.TdfMprotectTr
+	case "tdf_clone3_e":
+		return p.TdfClone3E
+	case "tdf_clone3_r":
+		return p.TdfClone3R
+	case "tdf_clone3_te":
+		return p.TdfClone3Te
+	case "tdf_clone3_tr":
+		return p.TdfClone3Tr
+	case "tdf_fork_e":
+		return p.TdfForkE
+	case "tdf_fork_r":
+		return p.TdfForkR
+	case "tdf_fork_te":
+		return p.TdfForkTe
+	case "tdf_fork_tr":
+		return p.TdfForkTr
+	case "tdf_vfork_e":
+		return p.TdfVforkE
+	case "tdf_vfork_r":
+		return p.TdfVforkR
+	case "tdf_vfork_te":
+		return p.TdfVforkTe
+	case "tdf_vfork_tr":
+		return p.TdfVforkTr
+	case "tdf_sendto_e":
+		return p.TdfSendtoE
+	case "tdf_sendto_r":
+		return p.TdfSendtoR
+	case "tdf_sendto_te":
+		return p.TdfSendtoTe
+	case "tdf_sendto_tr":
+		return p.TdfSendtoTr
+	case "tdf_recvfrom_e":
+		return p.TdfRecvfromE
+	case "tdf_recvfrom_r":
+		return p.TdfRecvfromR
+	case "tdf_recvfrom_te":
+		return p.TdfRecvfromTe
+	case "tdf_recvfrom_tr":
+		return p.TdfRecvfromTr
+	case "tdf_sendmsg_e":
+		return p.TdfSendmsgE
+	case "tdf_sendmsg_r":
+		return p.TdfSendmsgR
+	case "tdf_sendmsg_te":
+		return p.TdfSendmsgTe
+	case "tdf_sendmsg_tr":
+		return p.TdfSendmsgTr
+	case "tdf_recvmsg_e":
+		return p.TdfRecvmsgE
+	case "tdf_recvmsg_r":
+		return p.TdfRecvmsgR
+	case "tdf_recvmsg_te":
+		return p.TdfRecvmsgTe
+	case "tdf_recvmsg_tr":
+		return p.TdfRecvmsgTr
+	default:
+		return nil
+	}
+}
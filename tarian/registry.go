@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"runtime"
+	"slices"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var registryErr = err.New("tarian.registry")
+
+// probeDescriptor maps the name of an event to the pair of programs capturing it
+// and the architectures providing the syscall they are attached to.
+type probeDescriptor struct {
+	name     string   // Name of the event, e.g. execve
+	arches   []string // Architectures providing the syscall, named after GOARCH
+	optional bool     // Captured only if enabled in ModuleOptions.Events
+	entry    string   // Name of the program attached as kprobe
+	exit     string   // Name of the program attached as kretprobe
+
+	tpEntry string // Name of the program tail called from raw_syscalls:sys_enter
+	tpExit  string // Name of the program tail called from raw_syscalls:sys_exit
+}
+
+// ProbeStatus reports a probe available in the tarian module and its attach status.
+type ProbeStatus struct {
+	Name     string   // Name of the event captured by the probe
+	Hooks    []string // Hooks the programs of the probe are attached to
+	Enabled  bool     // Whether all programs of the probe are selected for attaching
+	Attached bool     // Whether all programs of the probe are attached to the kernel
+	Reason   string   // Reason a program of the probe was skipped or failed to attach, empty if none was
+}
+
+// supported reports whether the syscall of the probe exists on the architecture the detector runs on.
+func (pd probeDescriptor) supported() bool {
+	return slices.Contains(pd.arches, runtime.GOARCH)
+}
+
+// enabled reports whether the probe is captured with the given optional events enabled.
+func (pd probeDescriptor) enabled(events []string) bool {
+	return !pd.optional || slices.Contains(events, pd.name)
+}
+
+// symbol returns the kernel symbol hooked by both programs of the probe. It is the syscall wrapper
+// passing the struct pt_regs the programs read the arguments from, the probe is skipped without it.
+func (pd probeDescriptor) symbol() string {
+	return ebpf.SyscallSymbol(pd.name)
+}
+
+// entryHook returns the hook descriptor of the entry program.
+func (pd probeDescriptor) entryHook() *ebpf.HookInfo {
+	return ebpf.NewHookInfo().Kprobe(pd.symbol())
+}
+
+// exitHook returns the hook descriptor of the exit program.
+func (pd probeDescriptor) exitHook() *ebpf.HookInfo {
+	return ebpf.NewHookInfo().Kretprobe(pd.symbol())
+}
+
+// programs looks up the loaded programs of the probe and pairs them with their hooks.
+func (pd probeDescriptor) programs(objs *tarianPrograms) ([]*ebpf.ProgramInfo, error) {
+	entry, exit := objs.program(pd.entry), objs.program(pd.exit)
+	if entry == nil || exit == nil {
+		return nil, registryErr.Throwf("missing programs for probe %s: %s, %s", pd.name, pd.entry, pd.exit)
+	}
+
+	return []*ebpf.ProgramInfo{
+		ebpf.NewProgram(entry, pd.entryHook()),
+		ebpf.NewProgram(exit, pd.exitHook()),
+	}, nil
+}
+
+// tracepointPrograms looks up the loaded programs of the probe used in the tracepoint capture mode.
+func (pd probeDescriptor) tracepointPrograms(objs *tarianPrograms) (*cilium_ebpf.Program, *cilium_ebpf.Program, error) {
+	entry, exit := objs.program(pd.tpEntry), objs.program(pd.tpExit)
+	if entry == nil || exit == nil {
+		return nil, nil, registryErr.Throwf("missing programs for probe %s: %s, %s", pd.name, pd.tpEntry, pd.tpExit)
+	}
+
+	return entry, exit, nil
+}
+
+// OptionalEvents returns the names of the events captured only if enabled in ModuleOptions.Events,
+// such as the socket messages and the tcp connections whose volume makes them costly to capture.
+func OptionalEvents() []string {
+	var names []string
+	for _, pd := range syscallProbes {
+		if pd.optional {
+			names = append(names, pd.name)
+		}
+	}
+
+	for _, kh := range kernelHooks {
+		if kh.optional {
+			names = append(names, kh.event)
+		}
+	}
+
+	return names
+}
+
+// checkOptionalEvents verifies that every name is the name of an optional event.
+func checkOptionalEvents(names []string) error {
+	optional := OptionalEvents()
+	for _, name := range names {
+		if !slices.Contains(optional, name) {
+			return registryErr.Throwf("%q is not an optional event, expected one of %v", name, optional)
+		}
+	}
+
+	return nil
+}
+
+// ListProbes returns every probe registered in the tarian module. The attach status is
+// taken from the programs of the module and, if not nil, from the handler returned by Prepare.
+func ListProbes(m *ebpf.Module, h *ebpf.Handler) []ProbeStatus {
+	statuses := make([]ProbeStatus, 0, len(syscallProbes))
+
+	dispatchers := tracepointHooks(m)
+	for _, pd := range syscallProbes {
+		status := ProbeStatus{Name: pd.name}
+
+		progs := probePrograms(m, pd)
+		if len(dispatchers) != 0 && pd.supported() && len(progs) == 0 {
+			// in the tracepoint capture mode the programs of the probe are tail called by the dispatchers,
+			// unless it is an optional probe left disabled
+			progs = dispatchers
+		}
+		if len(progs) == 0 {
+			status.Hooks = []string{pd.entryHook().String(), pd.exitHook().String()}
+		} else {
+			status.Enabled = true
+			status.Attached = h != nil
+		}
+
+		for _, prog := range progs {
+			// the hooks of the module report the kernel symbols resolved by Prepare
+			status.Hooks = append(status.Hooks, prog.GetHook().String())
+			status.Enabled = status.Enabled && prog.GetShouldAttach()
+			status.Attached = status.Attached && h.IsAttached(prog)
+
+			if h == nil || len(status.Reason) != 0 {
+				continue
+			}
+
+			if pr, ok := h.Report().Lookup(prog); ok {
+				status.Reason = pr.Reason
+			}
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// probePrograms returns the programs of the module attached to the symbol of the probe.
+func probePrograms(m *ebpf.Module, pd probeDescriptor) []*ebpf.ProgramInfo {
+	var progs []*ebpf.ProgramInfo
+	if m == nil {
+		return progs
+	}
+
+	for _, prog := range m.GetPrograms() {
+		if prog.GetHook().GetHookName() == pd.symbol() {
+			progs = append(progs, prog)
+		}
+	}
+
+	return progs
+}
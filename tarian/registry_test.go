@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"runtime"
+	"testing"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+)
+
+// TestProbeDescriptor_programs tests the programs function
+func TestProbeDescriptor_programs(t *testing.T) {
+	tests := []struct {
+		name    string
+		pd      probeDescriptor
+		objs    *tarianPrograms
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "valid programs",
+			pd:   syscallProbes[0],
+			objs: &tarianPrograms{TdfExecveE: &cilium_ebpf.Program{}, TdfExecveR: &cilium_ebpf.Program{}},
+			want: 2,
+		},
+		{
+			name:    "missing programs",
+			pd:      syscallProbes[0],
+			objs:    &tarianPrograms{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.pd.programs(tt.objs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("probeDescriptor.programs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if len(got) != tt.want {
+				t.Errorf("probeDescriptor.programs() = %v, want %v", len(got), tt.want)
+			}
+		})
+	}
+}
+
+// TestListProbes tests the ListProbes function
+func TestListProbes(t *testing.T) {
+	pd := syscallProbes[0]
+	progs, err := pd.programs(&tarianPrograms{TdfExecveE: &cilium_ebpf.Program{}, TdfExecveR: &cilium_ebpf.Program{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := ebpf.NewModule("test")
+	for _, prog := range progs {
+		m.AddProgram(prog)
+	}
+
+	h := ebpf.NewHandler("test")
+	h.AddAttachedProgram(progs[0], nil)
+
+	tests := []struct {
+		name         string
+		handler      *ebpf.Handler
+		wantEnabled  bool
+		wantAttached bool
+	}{
+		{
+			name:         "not prepared",
+			handler:      nil,
+			wantEnabled:  true,
+			wantAttached: false,
+		},
+		{
+			name:         "partially attached",
+			handler:      h,
+			wantEnabled:  true,
+			wantAttached: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ListProbes(m, tt.handler)
+			if len(got) != len(syscallProbes) {
+				t.Fatalf("ListProbes() = %v, want %v", len(got), len(syscallProbes))
+			}
+
+			if got[0].Name != pd.name || len(got[0].Hooks) != 2 {
+				t.Errorf("ListProbes()[0] = %+v", got[0])
+			}
+
+			if got[0].Enabled != tt.wantEnabled || got[0].Attached != tt.wantAttached {
+				t.Errorf("ListProbes()[0] = %+v, want enabled %v attached %v", got[0], tt.wantEnabled, tt.wantAttached)
+			}
+
+			if got[1].Enabled || got[1].Attached {
+				t.Errorf("ListProbes()[1] = %+v, want a probe missing from the module", got[1])
+			}
+		})
+	}
+
+	h.AddSkippedProgram(progs[1], "symbol missing")
+	if got := ListProbes(m, h); got[0].Reason != "symbol missing" {
+		t.Errorf("ListProbes()[0].Reason = %v, want %v", got[0].Reason, "symbol missing")
+	}
+}
+
+// TestProbeDescriptor_supported tests the supported function
+func TestProbeDescriptor_supported(t *testing.T) {
+	tests := []struct {
+		name string
+		pd   probeDescriptor
+		want bool
+	}{
+		{
+			name: "current architecture",
+			pd:   probeDescriptor{name: "execve", arches: []string{runtime.GOARCH}},
+			want: true,
+		},
+		{
+			name: "other architecture",
+			pd:   probeDescriptor{name: "open", arches: []string{"unknown"}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pd.supported(); got != tt.want {
+				t.Errorf("probeDescriptor.supported() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProbeDescriptor_enabled tests the enabled function
+func TestProbeDescriptor_enabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		pd     probeDescriptor
+		events []string
+		want   bool
+	}{
+		{
+			name: "default probe",
+			pd:   probeDescriptor{name: "execve"},
+			want: true,
+		},
+		{
+			name: "optional probe left disabled",
+			pd:   probeDescriptor{name: "sendto", optional: true},
+			want: false,
+		},
+		{
+			name:   "optional probe enabled",
+			pd:     probeDescriptor{name: "sendto", optional: true},
+			events: []string{"recvfrom", "sendto"},
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pd.enabled(tt.events); got != tt.want {
+				t.Errorf("probeDescriptor.enabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCheckOptionalEvents tests the checkOptionalEvents function
+func TestCheckOptionalEvents(t *testing.T) {
+	if len(OptionalEvents()) == 0 {
+		t.Fatal("OptionalEvents() is empty")
+	}
+
+	tests := []struct {
+		name    string
+		events  []string
+		wantErr bool
+	}{
+		{name: "none", events: nil},
+		{name: "every optional event", events: OptionalEvents()},
+		{name: "default event", events: []string{"execve"}, wantErr: true},
+		{name: "unknown event", events: []string{"sendto", "unknown"}, wantErr: true},
+		{name: "connection event", events: []string{"connection_state"}},
+		{name: "default kernel function event", events: []string{"commit_creds"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkOptionalEvents(tt.events); (err != nil) != tt.wantErr {
+				t.Errorf("checkOptionalEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
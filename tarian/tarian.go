@@ -5,8 +5,11 @@ package tarian
 
 import (
 	"errors"
+	"slices"
 
 	cilium_ebpf "github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
 	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
 	"github.com/intelops/tarian-detector/pkg/err"
 	"github.com/intelops/tarian-detector/pkg/utils"
@@ -14,12 +17,50 @@ import (
 
 var tarianErr = err.New("tarian.tarian")
 
+// ringbufConstant is the read-only variable of the eBPF programs selecting the ring buffer transport.
+const ringbufConstant = "tarian_ringbuf"
+
+//go:generate go run ../tools/eventgen -schema events.json -go-events ../pkg/eventparser/events_gen.go -go-programs programs_gen.go -c-header c/utils/shared/events.h
 //go:generate go run github.com/cilium/ebpf/cmd/bpf2go -cc clang -cflags $BPF_CFLAGS -target $CURR_ARCH tarian c/tarian.bpf.c -- -I../headers -I./c
 
+// ModuleOptions configures the loading of the tarian module.
+type ModuleOptions struct {
+	BTFPath string // Path of the kernel BTF used for the CO-RE relocations, e.g. a file from BTFHub
+	BTFDir  string // Directory searched for the BTF of the running kernel release if the kernel exposes none
+	PinPath string // Directory of the BPF file system the maps and links are pinned under and re-adopted from, none if empty
+
+	Capture CaptureMode // Hooks the syscalls are captured from, kprobes by default
+	Events  []string    // Optional events captured in addition to the others, see OptionalEvents
+
+	TLSPaths   []string // Executables or shared libraries whose TLS plaintext is captured, e.g. libssl.so.3
+	TLSMaxData uint32   // Plaintext bytes captured per TLS read or write, MaxTLSData if zero
+
+	Policy  *Policy        // Rules enforced by the BPF LSM programs, which are only loaded and attached if not nil
+	Cgroups *CgroupMonitor // Attaches the cgroup programs on demand, which are only loaded if not nil
+}
+
 // GetModule loads the eBPF specifications, such as maps, programs, and structures, from a file.
 // It returns a pointer to an ebpf.Module and an error, if any occurred during the loading process.
-func GetModule() (*ebpf.Module, error) {
-	bpfObjs, err := getBpfObject()
+func GetModule(op ...ModuleOptions) (*ebpf.Module, error) {
+	var opts ModuleOptions
+	if len(op) > 0 {
+		opts = op[0]
+	}
+
+	if err := checkOptionalEvents(opts.Events); err != nil {
+		return nil, tarianErr.Throwf("%v", err)
+	}
+
+	kernelTypes, err := getKernelTypes(opts)
+	if err != nil {
+		return nil, tarianErr.Throwf("%v", err)
+	}
+
+	// Ring buffers preserve the order of the events and avoid the copy through the per-cpu
+	// buffers, the perf event array is kept as fallback for older kernels
+	ringbuf := ebpf.HaveRingBuf()
+
+	bpfObjs, pinPath, err := getBpfObject(ringbuf, kernelTypes, opts)
 	if err != nil {
 		var verr *cilium_ebpf.VerifierError
 		if errors.As(err, &verr) {
@@ -30,91 +71,214 @@ func GetModule() (*ebpf.Module, error) {
 	}
 
 	tarianDetectorModule := ebpf.NewModule("tarian_detector")
-	ckv, err := utils.CurrentKernelVersion()
-	if err != nil {
-		return nil, tarianErr.Throwf("failed to get current kernel version: %v", err)
+	if len(pinPath) != 0 {
+		tarianDetectorModule.PinLinks(pinPath)
 	}
 
-	if ckv >= utils.KernelVersion(5, 8, 0) && false {
-		tarianDetectorModule.Map(ebpf.NewArrayOfRingBuf(bpfObjs.Events))
+	if ringbuf {
+		tarianDetectorModule.Map(ebpf.NewArrayOfRingBuf(bpfObjs.EventsRingbuf))
 	} else {
 		tarianDetectorModule.Map(ebpf.NewPerfEventWithBuffer(bpfObjs.Events, bpfObjs.PeaPerCpuArray))
 	}
 
-	// kprobe & kretprobe execve
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfExecveE, ebpf.NewHookInfo().Kprobe("__x64_sys_execve")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfExecveR, ebpf.NewHookInfo().Kretprobe("__x64_sys_execve")))
+	if opts.Policy != nil {
+		progs, err := opts.Policy.bind(bpfObjs)
+		if err != nil {
+			return nil, tarianErr.Throwf("%v", err)
+		}
 
-	// kprobe & kretprobe execveat
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfExecveatE, ebpf.NewHookInfo().Kprobe("__x64_sys_execveat")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfExecveatR, ebpf.NewHookInfo().Kretprobe("__x64_sys_execveat")))
+		for _, prog := range progs {
+			tarianDetectorModule.AddProgram(prog)
+		}
+	}
 
-	// kprobe & kretprobe clone
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfCloneE, ebpf.NewHookInfo().Kprobe("__x64_sys_clone")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfCloneR, ebpf.NewHookInfo().Kretprobe("__x64_sys_clone")))
+	if opts.Cgroups != nil {
+		opts.Cgroups.bind(&bpfObjs.tarianPrograms)
+	}
 
-	// kprobe & kretprobe close
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfCloseE, ebpf.NewHookInfo().Kprobe("__x64_sys_close")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfCloseR, ebpf.NewHookInfo().Kretprobe("__x64_sys_close")))
+	for _, path := range opts.TLSPaths {
+		progs, err := tlsPrograms(&bpfObjs.tarianPrograms, path)
+		if err != nil {
+			return nil, tarianErr.Throwf("%v", err)
+		}
 
-	// kprobe & kretprobe read
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfReadE, ebpf.NewHookInfo().Kprobe("__x64_sys_read")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfReadR, ebpf.NewHookInfo().Kretprobe("__x64_sys_read")))
+		for _, prog := range progs {
+			tarianDetectorModule.AddProgram(prog)
+		}
+	}
 
-	// kprobe & kretprobe write
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfWriteE, ebpf.NewHookInfo().Kprobe("__x64_sys_write")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfWriteR, ebpf.NewHookInfo().Kretprobe("__x64_sys_write")))
+	for _, prog := range kernelPrograms(&bpfObjs.tarianPrograms, opts.Events) {
+		tarianDetectorModule.AddProgram(prog)
+	}
 
-	// kprobe & kretprobe open
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenE, ebpf.NewHookInfo().Kprobe("__x64_sys_open")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenR, ebpf.NewHookInfo().Kretprobe("__x64_sys_open")))
+	// The probes of the optional events left disabled are listed, but never attached or tail called
+	for _, pd := range syscallProbes {
+		if !pd.supported() || pd.enabled(opts.Events) {
+			continue
+		}
 
-	// kprobe & kretprobe readv
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfReadvE, ebpf.NewHookInfo().Kprobe("__x64_sys_readv")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfReadvR, ebpf.NewHookInfo().Kretprobe("__x64_sys_readv")))
+		progs, err := pd.programs(&bpfObjs.tarianPrograms)
+		if err != nil {
+			return nil, tarianErr.Throwf("%v", err)
+		}
 
-	// kprobe & kretprobe writev
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfWritevE, ebpf.NewHookInfo().Kprobe("__x64_sys_writev")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfWritevR, ebpf.NewHookInfo().Kretprobe("__x64_sys_writev")))
+		for _, prog := range progs {
+			tarianDetectorModule.AddProgram(prog.Disable())
+		}
+	}
 
-	// kprobe & kretprobe openat
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenatE, ebpf.NewHookInfo().Kprobe("__x64_sys_openat")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenatR, ebpf.NewHookInfo().Kretprobe("__x64_sys_openat")))
+	if opts.Capture == TracepointCapture {
+		if err := addTracepointPrograms(tarianDetectorModule, bpfObjs, opts.Events); err != nil {
+			return nil, tarianErr.Throwf("%v", err)
+		}
 
-	// kprobe & kretprobe openat2
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenat2E, ebpf.NewHookInfo().Kprobe("__x64_sys_openat2")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfOpenat2R, ebpf.NewHookInfo().Kretprobe("__x64_sys_openat2")))
+		return tarianDetectorModule, nil
+	}
 
-	// kprobe & kretprobe listen
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfListenE, ebpf.NewHookInfo().Kprobe("__x64_sys_listen")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfListenR, ebpf.NewHookInfo().Kretprobe("__x64_sys_listen")))
+	for _, pd := range syscallProbes {
+		if !pd.supported() || !pd.enabled(opts.Events) {
+			continue
+		}
 
-	// kprobe & kretprobe socket
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfSocketE, ebpf.NewHookInfo().Kprobe("__x64_sys_socket")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfSocketR, ebpf.NewHookInfo().Kretprobe("__x64_sys_socket")))
+		progs, err := pd.programs(&bpfObjs.tarianPrograms)
+		if err != nil {
+			return nil, tarianErr.Throwf("%v", err)
+		}
 
-	// kprobe & kretprobe accept
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfAcceptE, ebpf.NewHookInfo().Kprobe("__x64_sys_accept")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfAcceptR, ebpf.NewHookInfo().Kretprobe("__x64_sys_accept")))
+		for _, prog := range progs {
+			tarianDetectorModule.AddProgram(prog)
+		}
+	}
+
+	return tarianDetectorModule, nil
+}
 
-	// kprobe & kretprobe bind
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfBindE, ebpf.NewHookInfo().Kprobe("__x64_sys_bind")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfBindR, ebpf.NewHookInfo().Kretprobe("__x64_sys_bind")))
+// getKernelTypes returns the external BTF selected by the options, or nil to use the BTF of the running kernel.
+// The directory is only searched if the kernel exposes no BTF.
+func getKernelTypes(opts ModuleOptions) (*btf.Spec, error) {
+	if len(opts.BTFPath) != 0 {
+		return ebpf.LoadKernelTypes(opts.BTFPath)
+	}
 
-	// kprobe & kretprobe connect
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfConnectE, ebpf.NewHookInfo().Kprobe("__x64_sys_connect")))
-	tarianDetectorModule.AddProgram(ebpf.NewProgram(bpfObjs.TdfConnectR, ebpf.NewHookInfo().Kretprobe("__x64_sys_connect")))
+	if len(opts.BTFDir) == 0 || ebpf.HaveBTF() == nil {
+		return nil, nil
+	}
 
-	return tarianDetectorModule, nil
+	release, err := utils.KernelRelease()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := ebpf.FindKernelTypes(opts.BTFDir, release)
+	if err != nil {
+		return nil, err
+	}
+
+	return ebpf.LoadKernelTypes(path)
 }
 
-// loads the ebpf specs like maps, programs
-func getBpfObject() (*tarianObjects, error) {
+// loads the ebpf specs like maps, programs and selects the transport of the events, the capture mode,
+// the size of the TLS plaintext and whether the enforcement and cgroup programs are loaded. The kernel types, if not
+// nil, replace the BTF of the running kernel in the CO-RE relocations and the maps are pinned under the
+// pin path of the options, if not empty. It returns the directory the maps are pinned in, see ebpf.PinMaps.
+func getBpfObject(ringbuf bool, kernelTypes *btf.Spec, opts ModuleOptions) (*tarianObjects, string, error) {
+	tlsData, err := tlsMaxData(opts.TLSMaxData)
+	if err != nil {
+		return nil, "", err
+	}
+
+	spec, err := loadTarian()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !ringbuf {
+		disableRingbufs(spec)
+	}
+
+	if opts.Policy == nil {
+		disablePrograms(spec, cilium_ebpf.LSM)
+	}
+
+	if opts.Cgroups == nil {
+		disablePrograms(spec, cilium_ebpf.CGroupSockAddr, cilium_ebpf.CGroupSock)
+	}
+
+	err = spec.RewriteConstants(map[string]interface{}{
+		ringbufConstant:    boolConstant(ringbuf),
+		tracepointConstant: boolConstant(opts.Capture == TracepointCapture),
+		tlsMaxDataConstant: tlsData,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var mapOpts cilium_ebpf.MapOptions
+	if len(opts.PinPath) != 0 {
+		mapOpts, err = ebpf.PinMaps(spec, opts.PinPath)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
 	var bpfObj tarianObjects
-	err := loadTarianObjects(&bpfObj, nil)
+	err = spec.LoadAndAssign(&bpfObj, &cilium_ebpf.CollectionOptions{
+		Maps: mapOpts,
+		Programs: cilium_ebpf.ProgramOptions{
+			KernelTypes: kernelTypes,
+		},
+	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	return &bpfObj, mapOpts.PinPath, nil
+}
+
+// disableRingbufs replaces the ring buffer maps of the spec with minimal arrays, so that the
+// collection loads on kernels without ring buffers. The programs never use them in that case.
+func disableRingbufs(spec *cilium_ebpf.CollectionSpec) {
+	placeholder := func(m *cilium_ebpf.MapSpec) {
+		m.Type = cilium_ebpf.Array
+		m.KeySize = 4
+		m.ValueSize = 4
+		m.MaxEntries = 1
+	}
+
+	for _, m := range spec.Maps {
+		if m.Type == cilium_ebpf.RingBuf {
+			placeholder(m)
+		}
+
+		if m.InnerMap != nil && m.InnerMap.Type == cilium_ebpf.RingBuf {
+			placeholder(m.InnerMap)
+		}
+	}
+}
+
+// disablePrograms replaces the programs of the given types in the spec with programs returning zero, so
+// that the collection loads on kernels lacking these program types. They are never attached in that case.
+func disablePrograms(spec *cilium_ebpf.CollectionSpec, types ...cilium_ebpf.ProgramType) {
+	for _, p := range spec.Programs {
+		if !slices.Contains(types, p.Type) {
+			continue
+		}
+
+		p.Type = cilium_ebpf.SocketFilter
+		p.AttachType = cilium_ebpf.AttachNone
+		p.AttachTo = ""
+		p.Instructions = asm.Instructions{
+			asm.Mov.Imm(asm.R0, 0),
+			asm.Return(),
+		}
+	}
+}
+
+// boolConstant returns the value of a u8 read-only variable of the eBPF programs used as flag.
+func boolConstant(b bool) uint8 {
+	if b {
+		return 1
 	}
 
-	return &bpfObj, nil
+	return 0
 }
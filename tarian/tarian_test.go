@@ -4,86 +4,161 @@
 package tarian
 
 import (
-	"fmt"
-	"os"
+	"path/filepath"
 	"testing"
 
+	cilium_ebpf "github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
 	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
 )
 
-func setup(major, minor, patch any) {
-	os.Setenv("LINUX_VERSION_MAJOR", fmt.Sprintf("%v", major))
-	os.Setenv("LINUX_VERSION_MINOR", fmt.Sprintf("%v", minor))
-	os.Setenv("LINUX_VERSION_PATCH", fmt.Sprintf("%v", patch))
-}
-
-func teardown() {
-	os.Unsetenv("LINUX_VERSION_MAJOR")
-	os.Unsetenv("LINUX_VERSION_MINOR")
-	os.Unsetenv("LINUX_VERSION_PATCH")
-}
-
 // TestGetModule_Probe_count tests the GetModule function with a specific probe count.
 func TestGetModule_Probe_count(t *testing.T) {
-	setup(5, 8, 0)
 	got, err := GetModule()
-
 	if err != nil {
-		t.Errorf("GetModule() error = %v", err)
+		t.Fatalf("GetModule() error = %v", err)
+	}
+
+	// syscalls missing on the running architecture are not attached
+	probeCount := 0
+	for _, pd := range syscallProbes {
+		if pd.supported() {
+			probeCount += 2
+		}
 	}
 
-	probeCount := 16 * 2
 	if len(got.GetPrograms()) != probeCount {
 		t.Errorf("GetModule() = %v, want %v", len(got.GetPrograms()), probeCount)
 	}
-
-	teardown()
 }
 
-// TestGetModule_Perf_Check tests the GetModule function for the map type PerfEventArray
-func TestGetModule_Perf_Check(t *testing.T) {
-	setup(5, 6, 0)
+// TestGetModule_Map_Check tests that GetModule selects the map type supported by the running kernel
+func TestGetModule_Map_Check(t *testing.T) {
 	got, err := GetModule()
-
 	if err != nil {
-		t.Errorf("GetModule() error = %v", err)
+		t.Fatalf("GetModule() error = %v", err)
 	}
 
-	if got.GetMap().GetMapType() != ebpf.PerfEventArray {
-		t.Errorf("GetModule().ebpfMap = %v, want %v", got.GetMap().GetMapType(), ebpf.PerfEventArray)
+	want, wantInner := ebpf.PerfEventArray, ebpf.MapInfoType(-1)
+	if ebpf.HaveRingBuf() {
+		want, wantInner = ebpf.ArrayOfMaps, ebpf.RingBuffer
 	}
 
-	teardown()
+	if got.GetMap().GetMapType() != want || got.GetMap().GetInnerMapType() != wantInner {
+		t.Errorf("GetModule().ebpfMap = %v/%v, want %v/%v", got.GetMap().GetMapType(), got.GetMap().GetInnerMapType(), want, wantInner)
+	}
 }
 
-// TestGetModule_Ring_Check tests the GetModule function for the map type PerfEventArray
-func TestGetModule_Ring_Check(t *testing.T) {
-	setup(5, 19, 0)
-	got, err := GetModule()
+// TestDisableRingbufs tests that the ring buffer maps are replaced by placeholder arrays
+func TestDisableRingbufs(t *testing.T) {
+	ringbuf := &cilium_ebpf.MapSpec{Name: "erb_cpu0", Type: cilium_ebpf.RingBuf, MaxEntries: 1 << 23}
+	spec := &cilium_ebpf.CollectionSpec{
+		Maps: map[string]*cilium_ebpf.MapSpec{
+			"erb_cpu0": ringbuf,
+			"events_ringbuf": {
+				Name:       "events_ringbuf",
+				Type:       cilium_ebpf.ArrayOfMaps,
+				MaxEntries: 16,
+				InnerMap:   ringbuf.Copy(),
+			},
+			"events": {Name: "events", Type: cilium_ebpf.PerfEventArray},
+		},
+	}
 
-	if err != nil {
-		t.Errorf("GetModule() error = %v", err)
+	disableRingbufs(spec)
+
+	tests := []struct {
+		name string
+		got  cilium_ebpf.MapType
+		want cilium_ebpf.MapType
+	}{
+		{name: "ring buffer", got: spec.Maps["erb_cpu0"].Type, want: cilium_ebpf.Array},
+		{name: "array of ring buffers", got: spec.Maps["events_ringbuf"].Type, want: cilium_ebpf.ArrayOfMaps},
+		{name: "inner ring buffer", got: spec.Maps["events_ringbuf"].InnerMap.Type, want: cilium_ebpf.Array},
+		{name: "perf event array", got: spec.Maps["events"].Type, want: cilium_ebpf.PerfEventArray},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("disableRingbufs() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if spec.Maps["erb_cpu0"].MaxEntries != 1 {
+		t.Errorf("disableRingbufs() MaxEntries = %v, want %v", spec.Maps["erb_cpu0"].MaxEntries, 1)
 	}
+}
 
-	// this is intended to be a perf event as we are currently only supporting perf events
-	// once we were able to create an array ring buffer directly then we would need to change this test
-	if got.GetMap().GetMapType() != ebpf.PerfEventArray {
-		t.Errorf("GetModule().ebpfMap = %v, want %v", got.GetMap().GetMapType(), ebpf.PerfEventArray)
+// TestGetKernelTypes tests the selection of the BTF used for the CO-RE relocations
+func TestGetKernelTypes(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.btf")
+	embedded := ebpf.HaveBTF() == nil
+
+	tests := []struct {
+		name    string
+		opts    ModuleOptions
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "running kernel",
+			wantNil: true,
+		},
+		{
+			name:    "missing path",
+			opts:    ModuleOptions{BTFPath: missing},
+			wantNil: true,
+			wantErr: true,
+		},
+		{
+			name:    "missing release in directory",
+			opts:    ModuleOptions{BTFDir: t.TempDir()},
+			wantNil: true,
+			wantErr: !embedded,
+		},
 	}
 
-	teardown()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getKernelTypes(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getKernelTypes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (got == nil) != tt.wantNil {
+				t.Errorf("getKernelTypes() = %v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
 }
 
-// TestGetModule_Kernel_Version_Err tests the GetModule function with invalid arguments
-func TestGetModule_Kernel_Version_Err(t *testing.T) {
-	setup("ab", "cd", "ef") // invalid arguments
+// TestDisablePrograms tests that only the programs of the given types are replaced
+func TestDisablePrograms(t *testing.T) {
+	spec := &cilium_ebpf.CollectionSpec{
+		Programs: map[string]*cilium_ebpf.ProgramSpec{
+			"tdf_file_open":       {Name: "tdf_file_open", Type: cilium_ebpf.LSM, AttachType: cilium_ebpf.AttachLSMMac, AttachTo: "file_open"},
+			"tdf_cgroup_connect4": {Name: "tdf_cgroup_connect4", Type: cilium_ebpf.CGroupSockAddr, AttachType: cilium_ebpf.AttachCGroupInet4Connect},
+			"tdf_execve_e":        {Name: "tdf_execve_e", Type: cilium_ebpf.Kprobe},
+		},
+	}
 
-	_, err := GetModule()
+	disablePrograms(spec, cilium_ebpf.LSM, cilium_ebpf.CGroupSockAddr)
 
-	if err == nil {
-		t.Errorf("GetModule() error = %v, wantErr %v", err, "true")
+	for _, name := range []string{"tdf_file_open", "tdf_cgroup_connect4"} {
+		p := spec.Programs[name]
+		if p.Type != cilium_ebpf.SocketFilter || p.AttachType != cilium_ebpf.AttachNone || len(p.AttachTo) != 0 {
+			t.Errorf("disablePrograms() %s = %v %v %q, want a socket filter without attach target", name, p.Type, p.AttachType, p.AttachTo)
+		}
 
+		if len(p.Instructions) != 2 || p.Instructions[1].OpCode != asm.Return().OpCode {
+			t.Errorf("disablePrograms() %s Instructions = %v, want a program returning zero", name, p.Instructions)
+		}
 	}
 
-	teardown()
+	if spec.Programs["tdf_execve_e"].Type != cilium_ebpf.Kprobe {
+		t.Errorf("disablePrograms() = %v, want %v", spec.Programs["tdf_execve_e"].Type, cilium_ebpf.Kprobe)
+	}
 }
@@ -70,48 +70,306 @@ type tarianSpecs struct {
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type tarianProgramSpecs struct {
-	TdfAcceptE   *ebpf.ProgramSpec `ebpf:"tdf_accept_e"`
-	TdfAcceptR   *ebpf.ProgramSpec `ebpf:"tdf_accept_r"`
-	TdfBindE     *ebpf.ProgramSpec `ebpf:"tdf_bind_e"`
-	TdfBindR     *ebpf.ProgramSpec `ebpf:"tdf_bind_r"`
-	TdfCloneE    *ebpf.ProgramSpec `ebpf:"tdf_clone_e"`
-	TdfCloneR    *ebpf.ProgramSpec `ebpf:"tdf_clone_r"`
-	TdfCloseE    *ebpf.ProgramSpec `ebpf:"tdf_close_e"`
-	TdfCloseR    *ebpf.ProgramSpec `ebpf:"tdf_close_r"`
-	TdfConnectE  *ebpf.ProgramSpec `ebpf:"tdf_connect_e"`
-	TdfConnectR  *ebpf.ProgramSpec `ebpf:"tdf_connect_r"`
-	TdfExecveE   *ebpf.ProgramSpec `ebpf:"tdf_execve_e"`
-	TdfExecveR   *ebpf.ProgramSpec `ebpf:"tdf_execve_r"`
-	TdfExecveatE *ebpf.ProgramSpec `ebpf:"tdf_execveat_e"`
-	TdfExecveatR *ebpf.ProgramSpec `ebpf:"tdf_execveat_r"`
-	TdfListenE   *ebpf.ProgramSpec `ebpf:"tdf_listen_e"`
-	TdfListenR   *ebpf.ProgramSpec `ebpf:"tdf_listen_r"`
-	TdfOpenE     *ebpf.ProgramSpec `ebpf:"tdf_open_e"`
-	TdfOpenR     *ebpf.ProgramSpec `ebpf:"tdf_open_r"`
-	TdfOpenat2E  *ebpf.ProgramSpec `ebpf:"tdf_openat2_e"`
-	TdfOpenat2R  *ebpf.ProgramSpec `ebpf:"tdf_openat2_r"`
-	TdfOpenatE   *ebpf.ProgramSpec `ebpf:"tdf_openat_e"`
-	TdfOpenatR   *ebpf.ProgramSpec `ebpf:"tdf_openat_r"`
-	TdfReadE     *ebpf.ProgramSpec `ebpf:"tdf_read_e"`
-	TdfReadR     *ebpf.ProgramSpec `ebpf:"tdf_read_r"`
-	TdfReadvE    *ebpf.ProgramSpec `ebpf:"tdf_readv_e"`
-	TdfReadvR    *ebpf.ProgramSpec `ebpf:"tdf_readv_r"`
-	TdfSocketE   *ebpf.ProgramSpec `ebpf:"tdf_socket_e"`
-	TdfSocketR   *ebpf.ProgramSpec `ebpf:"tdf_socket_r"`
-	TdfWriteE    *ebpf.ProgramSpec `ebpf:"tdf_write_e"`
-	TdfWriteR    *ebpf.ProgramSpec `ebpf:"tdf_write_r"`
-	TdfWritevE   *ebpf.ProgramSpec `ebpf:"tdf_writev_e"`
-	TdfWritevR   *ebpf.ProgramSpec `ebpf:"tdf_writev_r"`
+	TdfAcceptE              *ebpf.ProgramSpec `ebpf:"tdf_accept_e"`
+	TdfAcceptR              *ebpf.ProgramSpec `ebpf:"tdf_accept_r"`
+	TdfAcceptTe             *ebpf.ProgramSpec `ebpf:"tdf_accept_te"`
+	TdfAcceptTr             *ebpf.ProgramSpec `ebpf:"tdf_accept_tr"`
+	TdfBindE                *ebpf.ProgramSpec `ebpf:"tdf_bind_e"`
+	TdfBindR                *ebpf.ProgramSpec `ebpf:"tdf_bind_r"`
+	TdfBindTe               *ebpf.ProgramSpec `ebpf:"tdf_bind_te"`
+	TdfBindTr               *ebpf.ProgramSpec `ebpf:"tdf_bind_tr"`
+	TdfBpfE                 *ebpf.ProgramSpec `ebpf:"tdf_bpf_e"`
+	TdfBpfR                 *ebpf.ProgramSpec `ebpf:"tdf_bpf_r"`
+	TdfBpfTe                *ebpf.ProgramSpec `ebpf:"tdf_bpf_te"`
+	TdfBpfTr                *ebpf.ProgramSpec `ebpf:"tdf_bpf_tr"`
+	TdfBprmCheckSecurity    *ebpf.ProgramSpec `ebpf:"tdf_bprm_check_security"`
+	TdfCapsetE              *ebpf.ProgramSpec `ebpf:"tdf_capset_e"`
+	TdfCapsetR              *ebpf.ProgramSpec `ebpf:"tdf_capset_r"`
+	TdfCapsetTe             *ebpf.ProgramSpec `ebpf:"tdf_capset_te"`
+	TdfCapsetTr             *ebpf.ProgramSpec `ebpf:"tdf_capset_tr"`
+	TdfCgroupConnect4       *ebpf.ProgramSpec `ebpf:"tdf_cgroup_connect4"`
+	TdfCgroupConnect6       *ebpf.ProgramSpec `ebpf:"tdf_cgroup_connect6"`
+	TdfCgroupSendmsg4       *ebpf.ProgramSpec `ebpf:"tdf_cgroup_sendmsg4"`
+	TdfCgroupSendmsg6       *ebpf.ProgramSpec `ebpf:"tdf_cgroup_sendmsg6"`
+	TdfCgroupSockCreate     *ebpf.ProgramSpec `ebpf:"tdf_cgroup_sock_create"`
+	TdfChmodE               *ebpf.ProgramSpec `ebpf:"tdf_chmod_e"`
+	TdfChmodR               *ebpf.ProgramSpec `ebpf:"tdf_chmod_r"`
+	TdfChmodTe              *ebpf.ProgramSpec `ebpf:"tdf_chmod_te"`
+	TdfChmodTr              *ebpf.ProgramSpec `ebpf:"tdf_chmod_tr"`
+	TdfChownE               *ebpf.ProgramSpec `ebpf:"tdf_chown_e"`
+	TdfChownR               *ebpf.ProgramSpec `ebpf:"tdf_chown_r"`
+	TdfChownTe              *ebpf.ProgramSpec `ebpf:"tdf_chown_te"`
+	TdfChownTr              *ebpf.ProgramSpec `ebpf:"tdf_chown_tr"`
+	TdfChrootE              *ebpf.ProgramSpec `ebpf:"tdf_chroot_e"`
+	TdfChrootR              *ebpf.ProgramSpec `ebpf:"tdf_chroot_r"`
+	TdfChrootTe             *ebpf.ProgramSpec `ebpf:"tdf_chroot_te"`
+	TdfChrootTr             *ebpf.ProgramSpec `ebpf:"tdf_chroot_tr"`
+	TdfClone3E              *ebpf.ProgramSpec `ebpf:"tdf_clone3_e"`
+	TdfClone3R              *ebpf.ProgramSpec `ebpf:"tdf_clone3_r"`
+	TdfClone3Te             *ebpf.ProgramSpec `ebpf:"tdf_clone3_te"`
+	TdfClone3Tr             *ebpf.ProgramSpec `ebpf:"tdf_clone3_tr"`
+	TdfCloneE               *ebpf.ProgramSpec `ebpf:"tdf_clone_e"`
+	TdfCloneR               *ebpf.ProgramSpec `ebpf:"tdf_clone_r"`
+	TdfCloneTe              *ebpf.ProgramSpec `ebpf:"tdf_clone_te"`
+	TdfCloneTr              *ebpf.ProgramSpec `ebpf:"tdf_clone_tr"`
+	TdfCloseE               *ebpf.ProgramSpec `ebpf:"tdf_close_e"`
+	TdfCloseR               *ebpf.ProgramSpec `ebpf:"tdf_close_r"`
+	TdfCloseTe              *ebpf.ProgramSpec `ebpf:"tdf_close_te"`
+	TdfCloseTr              *ebpf.ProgramSpec `ebpf:"tdf_close_tr"`
+	TdfCommitCreds          *ebpf.ProgramSpec `ebpf:"tdf_commit_creds"`
+	TdfConnectE             *ebpf.ProgramSpec `ebpf:"tdf_connect_e"`
+	TdfConnectR             *ebpf.ProgramSpec `ebpf:"tdf_connect_r"`
+	TdfConnectTe            *ebpf.ProgramSpec `ebpf:"tdf_connect_te"`
+	TdfConnectTr            *ebpf.ProgramSpec `ebpf:"tdf_connect_tr"`
+	TdfDeleteModuleE        *ebpf.ProgramSpec `ebpf:"tdf_delete_module_e"`
+	TdfDeleteModuleR        *ebpf.ProgramSpec `ebpf:"tdf_delete_module_r"`
+	TdfDeleteModuleTe       *ebpf.ProgramSpec `ebpf:"tdf_delete_module_te"`
+	TdfDeleteModuleTr       *ebpf.ProgramSpec `ebpf:"tdf_delete_module_tr"`
+	TdfDoInitModule         *ebpf.ProgramSpec `ebpf:"tdf_do_init_module"`
+	TdfExecveE              *ebpf.ProgramSpec `ebpf:"tdf_execve_e"`
+	TdfExecveR              *ebpf.ProgramSpec `ebpf:"tdf_execve_r"`
+	TdfExecveTe             *ebpf.ProgramSpec `ebpf:"tdf_execve_te"`
+	TdfExecveTr             *ebpf.ProgramSpec `ebpf:"tdf_execve_tr"`
+	TdfExecveatE            *ebpf.ProgramSpec `ebpf:"tdf_execveat_e"`
+	TdfExecveatR            *ebpf.ProgramSpec `ebpf:"tdf_execveat_r"`
+	TdfExecveatTe           *ebpf.ProgramSpec `ebpf:"tdf_execveat_te"`
+	TdfExecveatTr           *ebpf.ProgramSpec `ebpf:"tdf_execveat_tr"`
+	TdfFchmodatE            *ebpf.ProgramSpec `ebpf:"tdf_fchmodat_e"`
+	TdfFchmodatR            *ebpf.ProgramSpec `ebpf:"tdf_fchmodat_r"`
+	TdfFchmodatTe           *ebpf.ProgramSpec `ebpf:"tdf_fchmodat_te"`
+	TdfFchmodatTr           *ebpf.ProgramSpec `ebpf:"tdf_fchmodat_tr"`
+	TdfFchownatE            *ebpf.ProgramSpec `ebpf:"tdf_fchownat_e"`
+	TdfFchownatR            *ebpf.ProgramSpec `ebpf:"tdf_fchownat_r"`
+	TdfFchownatTe           *ebpf.ProgramSpec `ebpf:"tdf_fchownat_te"`
+	TdfFchownatTr           *ebpf.ProgramSpec `ebpf:"tdf_fchownat_tr"`
+	TdfFileOpen             *ebpf.ProgramSpec `ebpf:"tdf_file_open"`
+	TdfFinitModuleE         *ebpf.ProgramSpec `ebpf:"tdf_finit_module_e"`
+	TdfFinitModuleR         *ebpf.ProgramSpec `ebpf:"tdf_finit_module_r"`
+	TdfFinitModuleTe        *ebpf.ProgramSpec `ebpf:"tdf_finit_module_te"`
+	TdfFinitModuleTr        *ebpf.ProgramSpec `ebpf:"tdf_finit_module_tr"`
+	TdfForkE                *ebpf.ProgramSpec `ebpf:"tdf_fork_e"`
+	TdfForkR                *ebpf.ProgramSpec `ebpf:"tdf_fork_r"`
+	TdfForkTe               *ebpf.ProgramSpec `ebpf:"tdf_fork_te"`
+	TdfForkTr               *ebpf.ProgramSpec `ebpf:"tdf_fork_tr"`
+	TdfFtruncateE           *ebpf.ProgramSpec `ebpf:"tdf_ftruncate_e"`
+	TdfFtruncateR           *ebpf.ProgramSpec `ebpf:"tdf_ftruncate_r"`
+	TdfFtruncateTe          *ebpf.ProgramSpec `ebpf:"tdf_ftruncate_te"`
+	TdfFtruncateTr          *ebpf.ProgramSpec `ebpf:"tdf_ftruncate_tr"`
+	TdfGotlsReadE           *ebpf.ProgramSpec `ebpf:"tdf_gotls_read_e"`
+	TdfGotlsReadR           *ebpf.ProgramSpec `ebpf:"tdf_gotls_read_r"`
+	TdfGotlsWriteE          *ebpf.ProgramSpec `ebpf:"tdf_gotls_write_e"`
+	TdfInetCskAccept        *ebpf.ProgramSpec `ebpf:"tdf_inet_csk_accept"`
+	TdfInitModuleE          *ebpf.ProgramSpec `ebpf:"tdf_init_module_e"`
+	TdfInitModuleR          *ebpf.ProgramSpec `ebpf:"tdf_init_module_r"`
+	TdfInitModuleTe         *ebpf.ProgramSpec `ebpf:"tdf_init_module_te"`
+	TdfInitModuleTr         *ebpf.ProgramSpec `ebpf:"tdf_init_module_tr"`
+	TdfLinkE                *ebpf.ProgramSpec `ebpf:"tdf_link_e"`
+	TdfLinkR                *ebpf.ProgramSpec `ebpf:"tdf_link_r"`
+	TdfLinkTe               *ebpf.ProgramSpec `ebpf:"tdf_link_te"`
+	TdfLinkTr               *ebpf.ProgramSpec `ebpf:"tdf_link_tr"`
+	TdfLinkatE              *ebpf.ProgramSpec `ebpf:"tdf_linkat_e"`
+	TdfLinkatR              *ebpf.ProgramSpec `ebpf:"tdf_linkat_r"`
+	TdfLinkatTe             *ebpf.ProgramSpec `ebpf:"tdf_linkat_te"`
+	TdfLinkatTr             *ebpf.ProgramSpec `ebpf:"tdf_linkat_tr"`
+	TdfListenE              *ebpf.ProgramSpec `ebpf:"tdf_listen_e"`
+	TdfListenR              *ebpf.ProgramSpec `ebpf:"tdf_listen_r"`
+	TdfListenTe             *ebpf.ProgramSpec `ebpf:"tdf_listen_te"`
+	TdfListenTr             *ebpf.ProgramSpec `ebpf:"tdf_listen_tr"`
+	TdfMemfdCreateE         *ebpf.ProgramSpec `ebpf:"tdf_memfd_create_e"`
+	TdfMemfdCreateR         *ebpf.ProgramSpec `ebpf:"tdf_memfd_create_r"`
+	TdfMemfdCreateTe        *ebpf.ProgramSpec `ebpf:"tdf_memfd_create_te"`
+	TdfMemfdCreateTr        *ebpf.ProgramSpec `ebpf:"tdf_memfd_create_tr"`
+	TdfMmapE                *ebpf.ProgramSpec `ebpf:"tdf_mmap_e"`
+	TdfMmapR                *ebpf.ProgramSpec `ebpf:"tdf_mmap_r"`
+	TdfMmapTe               *ebpf.ProgramSpec `ebpf:"tdf_mmap_te"`
+	TdfMmapTr               *ebpf.ProgramSpec `ebpf:"tdf_mmap_tr"`
+	TdfMountE               *ebpf.ProgramSpec `ebpf:"tdf_mount_e"`
+	TdfMountR               *ebpf.ProgramSpec `ebpf:"tdf_mount_r"`
+	TdfMountTe              *ebpf.ProgramSpec `ebpf:"tdf_mount_te"`
+	TdfMountTr              *ebpf.ProgramSpec `ebpf:"tdf_mount_tr"`
+	TdfMprotectE            *ebpf.ProgramSpec `ebpf:"tdf_mprotect_e"`
+	TdfMprotectR            *ebpf.ProgramSpec `ebpf:"tdf_mprotect_r"`
+	TdfMprotectTe           *ebpf.ProgramSpec `ebpf:"tdf_mprotect_te"`
+	TdfMprotectTr           *ebpf.ProgramSpec `ebpf:"tdf_mprotect_tr"`
+	TdfOpenE                *ebpf.ProgramSpec `ebpf:"tdf_open_e"`
+	TdfOpenR                *ebpf.ProgramSpec `ebpf:"tdf_open_r"`
+	TdfOpenTe               *ebpf.ProgramSpec `ebpf:"tdf_open_te"`
+	TdfOpenTr               *ebpf.ProgramSpec `ebpf:"tdf_open_tr"`
+	TdfOpenat2E             *ebpf.ProgramSpec `ebpf:"tdf_openat2_e"`
+	TdfOpenat2R             *ebpf.ProgramSpec `ebpf:"tdf_openat2_r"`
+	TdfOpenat2Te            *ebpf.ProgramSpec `ebpf:"tdf_openat2_te"`
+	TdfOpenat2Tr            *ebpf.ProgramSpec `ebpf:"tdf_openat2_tr"`
+	TdfOpenatE              *ebpf.ProgramSpec `ebpf:"tdf_openat_e"`
+	TdfOpenatR              *ebpf.ProgramSpec `ebpf:"tdf_openat_r"`
+	TdfOpenatTe             *ebpf.ProgramSpec `ebpf:"tdf_openat_te"`
+	TdfOpenatTr             *ebpf.ProgramSpec `ebpf:"tdf_openat_tr"`
+	TdfPivotRootE           *ebpf.ProgramSpec `ebpf:"tdf_pivot_root_e"`
+	TdfPivotRootR           *ebpf.ProgramSpec `ebpf:"tdf_pivot_root_r"`
+	TdfPivotRootTe          *ebpf.ProgramSpec `ebpf:"tdf_pivot_root_te"`
+	TdfPivotRootTr          *ebpf.ProgramSpec `ebpf:"tdf_pivot_root_tr"`
+	TdfProcessVmReadvE      *ebpf.ProgramSpec `ebpf:"tdf_process_vm_readv_e"`
+	TdfProcessVmReadvR      *ebpf.ProgramSpec `ebpf:"tdf_process_vm_readv_r"`
+	TdfProcessVmReadvTe     *ebpf.ProgramSpec `ebpf:"tdf_process_vm_readv_te"`
+	TdfProcessVmReadvTr     *ebpf.ProgramSpec `ebpf:"tdf_process_vm_readv_tr"`
+	TdfProcessVmWritevE     *ebpf.ProgramSpec `ebpf:"tdf_process_vm_writev_e"`
+	TdfProcessVmWritevR     *ebpf.ProgramSpec `ebpf:"tdf_process_vm_writev_r"`
+	TdfProcessVmWritevTe    *ebpf.ProgramSpec `ebpf:"tdf_process_vm_writev_te"`
+	TdfProcessVmWritevTr    *ebpf.ProgramSpec `ebpf:"tdf_process_vm_writev_tr"`
+	TdfPtraceE              *ebpf.ProgramSpec `ebpf:"tdf_ptrace_e"`
+	TdfPtraceR              *ebpf.ProgramSpec `ebpf:"tdf_ptrace_r"`
+	TdfPtraceTe             *ebpf.ProgramSpec `ebpf:"tdf_ptrace_te"`
+	TdfPtraceTr             *ebpf.ProgramSpec `ebpf:"tdf_ptrace_tr"`
+	TdfReadE                *ebpf.ProgramSpec `ebpf:"tdf_read_e"`
+	TdfReadR                *ebpf.ProgramSpec `ebpf:"tdf_read_r"`
+	TdfReadTe               *ebpf.ProgramSpec `ebpf:"tdf_read_te"`
+	TdfReadTr               *ebpf.ProgramSpec `ebpf:"tdf_read_tr"`
+	TdfReadvE               *ebpf.ProgramSpec `ebpf:"tdf_readv_e"`
+	TdfReadvR               *ebpf.ProgramSpec `ebpf:"tdf_readv_r"`
+	TdfReadvTe              *ebpf.ProgramSpec `ebpf:"tdf_readv_te"`
+	TdfReadvTr              *ebpf.ProgramSpec `ebpf:"tdf_readv_tr"`
+	TdfRecvfromE            *ebpf.ProgramSpec `ebpf:"tdf_recvfrom_e"`
+	TdfRecvfromR            *ebpf.ProgramSpec `ebpf:"tdf_recvfrom_r"`
+	TdfRecvfromTe           *ebpf.ProgramSpec `ebpf:"tdf_recvfrom_te"`
+	TdfRecvfromTr           *ebpf.ProgramSpec `ebpf:"tdf_recvfrom_tr"`
+	TdfRecvmsgE             *ebpf.ProgramSpec `ebpf:"tdf_recvmsg_e"`
+	TdfRecvmsgR             *ebpf.ProgramSpec `ebpf:"tdf_recvmsg_r"`
+	TdfRecvmsgTe            *ebpf.ProgramSpec `ebpf:"tdf_recvmsg_te"`
+	TdfRecvmsgTr            *ebpf.ProgramSpec `ebpf:"tdf_recvmsg_tr"`
+	TdfRenameE              *ebpf.ProgramSpec `ebpf:"tdf_rename_e"`
+	TdfRenameR              *ebpf.ProgramSpec `ebpf:"tdf_rename_r"`
+	TdfRenameTe             *ebpf.ProgramSpec `ebpf:"tdf_rename_te"`
+	TdfRenameTr             *ebpf.ProgramSpec `ebpf:"tdf_rename_tr"`
+	TdfRenameat2E           *ebpf.ProgramSpec `ebpf:"tdf_renameat2_e"`
+	TdfRenameat2R           *ebpf.ProgramSpec `ebpf:"tdf_renameat2_r"`
+	TdfRenameat2Te          *ebpf.ProgramSpec `ebpf:"tdf_renameat2_te"`
+	TdfRenameat2Tr          *ebpf.ProgramSpec `ebpf:"tdf_renameat2_tr"`
+	TdfSecurityFileMprotect *ebpf.ProgramSpec `ebpf:"tdf_security_file_mprotect"`
+	TdfSendmsgE             *ebpf.ProgramSpec `ebpf:"tdf_sendmsg_e"`
+	TdfSendmsgR             *ebpf.ProgramSpec `ebpf:"tdf_sendmsg_r"`
+	TdfSendmsgTe            *ebpf.ProgramSpec `ebpf:"tdf_sendmsg_te"`
+	TdfSendmsgTr            *ebpf.ProgramSpec `ebpf:"tdf_sendmsg_tr"`
+	TdfSendtoE              *ebpf.ProgramSpec `ebpf:"tdf_sendto_e"`
+	TdfSendtoR              *ebpf.ProgramSpec `ebpf:"tdf_sendto_r"`
+	TdfSendtoTe             *ebpf.ProgramSpec `ebpf:"tdf_sendto_te"`
+	TdfSendtoTr             *ebpf.ProgramSpec `ebpf:"tdf_sendto_tr"`
+	TdfSetfsuidE            *ebpf.ProgramSpec `ebpf:"tdf_setfsuid_e"`
+	TdfSetfsuidR            *ebpf.ProgramSpec `ebpf:"tdf_setfsuid_r"`
+	TdfSetfsuidTe           *ebpf.ProgramSpec `ebpf:"tdf_setfsuid_te"`
+	TdfSetfsuidTr           *ebpf.ProgramSpec `ebpf:"tdf_setfsuid_tr"`
+	TdfSetgidE              *ebpf.ProgramSpec `ebpf:"tdf_setgid_e"`
+	TdfSetgidR              *ebpf.ProgramSpec `ebpf:"tdf_setgid_r"`
+	TdfSetgidTe             *ebpf.ProgramSpec `ebpf:"tdf_setgid_te"`
+	TdfSetgidTr             *ebpf.ProgramSpec `ebpf:"tdf_setgid_tr"`
+	TdfSetnsE               *ebpf.ProgramSpec `ebpf:"tdf_setns_e"`
+	TdfSetnsR               *ebpf.ProgramSpec `ebpf:"tdf_setns_r"`
+	TdfSetnsTe              *ebpf.ProgramSpec `ebpf:"tdf_setns_te"`
+	TdfSetnsTr              *ebpf.ProgramSpec `ebpf:"tdf_setns_tr"`
+	TdfSetresgidE           *ebpf.ProgramSpec `ebpf:"tdf_setresgid_e"`
+	TdfSetresgidR           *ebpf.ProgramSpec `ebpf:"tdf_setresgid_r"`
+	TdfSetresgidTe          *ebpf.ProgramSpec `ebpf:"tdf_setresgid_te"`
+	TdfSetresgidTr          *ebpf.ProgramSpec `ebpf:"tdf_setresgid_tr"`
+	TdfSetresuidE           *ebpf.ProgramSpec `ebpf:"tdf_setresuid_e"`
+	TdfSetresuidR           *ebpf.ProgramSpec `ebpf:"tdf_setresuid_r"`
+	TdfSetresuidTe          *ebpf.ProgramSpec `ebpf:"tdf_setresuid_te"`
+	TdfSetresuidTr          *ebpf.ProgramSpec `ebpf:"tdf_setresuid_tr"`
+	TdfSetreuidE            *ebpf.ProgramSpec `ebpf:"tdf_setreuid_e"`
+	TdfSetreuidR            *ebpf.ProgramSpec `ebpf:"tdf_setreuid_r"`
+	TdfSetreuidTe           *ebpf.ProgramSpec `ebpf:"tdf_setreuid_te"`
+	TdfSetreuidTr           *ebpf.ProgramSpec `ebpf:"tdf_setreuid_tr"`
+	TdfSetuidE              *ebpf.ProgramSpec `ebpf:"tdf_setuid_e"`
+	TdfSetuidR              *ebpf.ProgramSpec `ebpf:"tdf_setuid_r"`
+	TdfSetuidTe             *ebpf.ProgramSpec `ebpf:"tdf_setuid_te"`
+	TdfSetuidTr             *ebpf.ProgramSpec `ebpf:"tdf_setuid_tr"`
+	TdfSocketConnect        *ebpf.ProgramSpec `ebpf:"tdf_socket_connect"`
+	TdfSocketE              *ebpf.ProgramSpec `ebpf:"tdf_socket_e"`
+	TdfSocketR              *ebpf.ProgramSpec `ebpf:"tdf_socket_r"`
+	TdfSocketTe             *ebpf.ProgramSpec `ebpf:"tdf_socket_te"`
+	TdfSocketTr             *ebpf.ProgramSpec `ebpf:"tdf_socket_tr"`
+	TdfSslE                 *ebpf.ProgramSpec `ebpf:"tdf_ssl_e"`
+	TdfSslReadR             *ebpf.ProgramSpec `ebpf:"tdf_ssl_read_r"`
+	TdfSslWriteR            *ebpf.ProgramSpec `ebpf:"tdf_ssl_write_r"`
+	TdfSymlinkE             *ebpf.ProgramSpec `ebpf:"tdf_symlink_e"`
+	TdfSymlinkR             *ebpf.ProgramSpec `ebpf:"tdf_symlink_r"`
+	TdfSymlinkTe            *ebpf.ProgramSpec `ebpf:"tdf_symlink_te"`
+	TdfSymlinkTr            *ebpf.ProgramSpec `ebpf:"tdf_symlink_tr"`
+	TdfSymlinkatE           *ebpf.ProgramSpec `ebpf:"tdf_symlinkat_e"`
+	TdfSymlinkatR           *ebpf.ProgramSpec `ebpf:"tdf_symlinkat_r"`
+	TdfSymlinkatTe          *ebpf.ProgramSpec `ebpf:"tdf_symlinkat_te"`
+	TdfSymlinkatTr          *ebpf.ProgramSpec `ebpf:"tdf_symlinkat_tr"`
+	TdfSysEnter             *ebpf.ProgramSpec `ebpf:"tdf_sys_enter"`
+	TdfSysExit              *ebpf.ProgramSpec `ebpf:"tdf_sys_exit"`
+	TdfTcpClose             *ebpf.ProgramSpec `ebpf:"tdf_tcp_close"`
+	TdfTcpConnect           *ebpf.ProgramSpec `ebpf:"tdf_tcp_connect"`
+	TdfTcpSetState          *ebpf.ProgramSpec `ebpf:"tdf_tcp_set_state"`
+	TdfTruncateE            *ebpf.ProgramSpec `ebpf:"tdf_truncate_e"`
+	TdfTruncateR            *ebpf.ProgramSpec `ebpf:"tdf_truncate_r"`
+	TdfTruncateTe           *ebpf.ProgramSpec `ebpf:"tdf_truncate_te"`
+	TdfTruncateTr           *ebpf.ProgramSpec `ebpf:"tdf_truncate_tr"`
+	TdfUmountE              *ebpf.ProgramSpec `ebpf:"tdf_umount_e"`
+	TdfUmountR              *ebpf.ProgramSpec `ebpf:"tdf_umount_r"`
+	TdfUmountTe             *ebpf.ProgramSpec `ebpf:"tdf_umount_te"`
+	TdfUmountTr             *ebpf.ProgramSpec `ebpf:"tdf_umount_tr"`
+	TdfUnlinkE              *ebpf.ProgramSpec `ebpf:"tdf_unlink_e"`
+	TdfUnlinkR              *ebpf.ProgramSpec `ebpf:"tdf_unlink_r"`
+	TdfUnlinkTe             *ebpf.ProgramSpec `ebpf:"tdf_unlink_te"`
+	TdfUnlinkTr             *ebpf.ProgramSpec `ebpf:"tdf_unlink_tr"`
+	TdfUnlinkatE            *ebpf.ProgramSpec `ebpf:"tdf_unlinkat_e"`
+	TdfUnlinkatR            *ebpf.ProgramSpec `ebpf:"tdf_unlinkat_r"`
+	TdfUnlinkatTe           *ebpf.ProgramSpec `ebpf:"tdf_unlinkat_te"`
+	TdfUnlinkatTr           *ebpf.ProgramSpec `ebpf:"tdf_unlinkat_tr"`
+	TdfUnshareE             *ebpf.ProgramSpec `ebpf:"tdf_unshare_e"`
+	TdfUnshareR             *ebpf.ProgramSpec `ebpf:"tdf_unshare_r"`
+	TdfUnshareTe            *ebpf.ProgramSpec `ebpf:"tdf_unshare_te"`
+	TdfUnshareTr            *ebpf.ProgramSpec `ebpf:"tdf_unshare_tr"`
+	TdfVforkE               *ebpf.ProgramSpec `ebpf:"tdf_vfork_e"`
+	TdfVforkR               *ebpf.ProgramSpec `ebpf:"tdf_vfork_r"`
+	TdfVforkTe              *ebpf.ProgramSpec `ebpf:"tdf_vfork_te"`
+	TdfVforkTr              *ebpf.ProgramSpec `ebpf:"tdf_vfork_tr"`
+	TdfWriteE               *ebpf.ProgramSpec `ebpf:"tdf_write_e"`
+	TdfWriteR               *ebpf.ProgramSpec `ebpf:"tdf_write_r"`
+	TdfWriteTe              *ebpf.ProgramSpec `ebpf:"tdf_write_te"`
+	TdfWriteTr              *ebpf.ProgramSpec `ebpf:"tdf_write_tr"`
+	TdfWritevE              *ebpf.ProgramSpec `ebpf:"tdf_writev_e"`
+	TdfWritevR              *ebpf.ProgramSpec `ebpf:"tdf_writev_r"`
+	TdfWritevTe             *ebpf.ProgramSpec `ebpf:"tdf_writev_te"`
+	TdfWritevTr             *ebpf.ProgramSpec `ebpf:"tdf_writev_tr"`
 }
 
 // tarianMapSpecs contains maps before they are loaded into the kernel.
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type tarianMapSpecs struct {
+	DenyConnect    *ebpf.MapSpec `ebpf:"deny_connect"`
+	DenyExec       *ebpf.MapSpec `ebpf:"deny_exec"`
+	DenyOpen       *ebpf.MapSpec `ebpf:"deny_open"`
+	ErbCpu0        *ebpf.MapSpec `ebpf:"erb_cpu0"`
+	ErbCpu1        *ebpf.MapSpec `ebpf:"erb_cpu1"`
+	ErbCpu10       *ebpf.MapSpec `ebpf:"erb_cpu10"`
+	ErbCpu11       *ebpf.MapSpec `ebpf:"erb_cpu11"`
+	ErbCpu12       *ebpf.MapSpec `ebpf:"erb_cpu12"`
+	ErbCpu13       *ebpf.MapSpec `ebpf:"erb_cpu13"`
+	ErbCpu14       *ebpf.MapSpec `ebpf:"erb_cpu14"`
+	ErbCpu15       *ebpf.MapSpec `ebpf:"erb_cpu15"`
+	ErbCpu2        *ebpf.MapSpec `ebpf:"erb_cpu2"`
+	ErbCpu3        *ebpf.MapSpec `ebpf:"erb_cpu3"`
+	ErbCpu4        *ebpf.MapSpec `ebpf:"erb_cpu4"`
+	ErbCpu5        *ebpf.MapSpec `ebpf:"erb_cpu5"`
+	ErbCpu6        *ebpf.MapSpec `ebpf:"erb_cpu6"`
+	ErbCpu7        *ebpf.MapSpec `ebpf:"erb_cpu7"`
+	ErbCpu8        *ebpf.MapSpec `ebpf:"erb_cpu8"`
+	ErbCpu9        *ebpf.MapSpec `ebpf:"erb_cpu9"`
 	Events         *ebpf.MapSpec `ebpf:"events"`
+	EventsRingbuf  *ebpf.MapSpec `ebpf:"events_ringbuf"`
+	ExecMemCalls   *ebpf.MapSpec `ebpf:"exec_mem_calls"`
+	GoTlsReads     *ebpf.MapSpec `ebpf:"go_tls_reads"`
 	PeaPerCpuArray *ebpf.MapSpec `ebpf:"pea_per_cpu_array"`
+	RecvCalls      *ebpf.MapSpec `ebpf:"recv_calls"`
 	ScratchSpace   *ebpf.MapSpec `ebpf:"scratch_space"`
+	SockOwners     *ebpf.MapSpec `ebpf:"sock_owners"`
+	SysEnterCalls  *ebpf.MapSpec `ebpf:"sys_enter_calls"`
+	SysExitCalls   *ebpf.MapSpec `ebpf:"sys_exit_calls"`
 	TarianStats    *ebpf.MapSpec `ebpf:"tarian_stats"`
+	TlsBuffers     *ebpf.MapSpec `ebpf:"tls_buffers"`
 }
 
 // tarianObjects contains all objects after they have been loaded into the kernel.
@@ -133,18 +391,72 @@ func (o *tarianObjects) Close() error {
 //
 // It can be passed to loadTarianObjects or ebpf.CollectionSpec.LoadAndAssign.
 type tarianMaps struct {
+	DenyConnect    *ebpf.Map `ebpf:"deny_connect"`
+	DenyExec       *ebpf.Map `ebpf:"deny_exec"`
+	DenyOpen       *ebpf.Map `ebpf:"deny_open"`
+	ErbCpu0        *ebpf.Map `ebpf:"erb_cpu0"`
+	ErbCpu1        *ebpf.Map `ebpf:"erb_cpu1"`
+	ErbCpu10       *ebpf.Map `ebpf:"erb_cpu10"`
+	ErbCpu11       *ebpf.Map `ebpf:"erb_cpu11"`
+	ErbCpu12       *ebpf.Map `ebpf:"erb_cpu12"`
+	ErbCpu13       *ebpf.Map `ebpf:"erb_cpu13"`
+	ErbCpu14       *ebpf.Map `ebpf:"erb_cpu14"`
+	ErbCpu15       *ebpf.Map `ebpf:"erb_cpu15"`
+	ErbCpu2        *ebpf.Map `ebpf:"erb_cpu2"`
+	ErbCpu3        *ebpf.Map `ebpf:"erb_cpu3"`
+	ErbCpu4        *ebpf.Map `ebpf:"erb_cpu4"`
+	ErbCpu5        *ebpf.Map `ebpf:"erb_cpu5"`
+	ErbCpu6        *ebpf.Map `ebpf:"erb_cpu6"`
+	ErbCpu7        *ebpf.Map `ebpf:"erb_cpu7"`
+	ErbCpu8        *ebpf.Map `ebpf:"erb_cpu8"`
+	ErbCpu9        *ebpf.Map `ebpf:"erb_cpu9"`
 	Events         *ebpf.Map `ebpf:"events"`
+	EventsRingbuf  *ebpf.Map `ebpf:"events_ringbuf"`
+	ExecMemCalls   *ebpf.Map `ebpf:"exec_mem_calls"`
+	GoTlsReads     *ebpf.Map `ebpf:"go_tls_reads"`
 	PeaPerCpuArray *ebpf.Map `ebpf:"pea_per_cpu_array"`
+	RecvCalls      *ebpf.Map `ebpf:"recv_calls"`
 	ScratchSpace   *ebpf.Map `ebpf:"scratch_space"`
+	SockOwners     *ebpf.Map `ebpf:"sock_owners"`
+	SysEnterCalls  *ebpf.Map `ebpf:"sys_enter_calls"`
+	SysExitCalls   *ebpf.Map `ebpf:"sys_exit_calls"`
 	TarianStats    *ebpf.Map `ebpf:"tarian_stats"`
+	TlsBuffers     *ebpf.Map `ebpf:"tls_buffers"`
 }
 
 func (m *tarianMaps) Close() error {
 	return _TarianClose(
+		m.DenyConnect,
+		m.DenyExec,
+		m.DenyOpen,
+		m.ErbCpu0,
+		m.ErbCpu1,
+		m.ErbCpu10,
+		m.ErbCpu11,
+		m.ErbCpu12,
+		m.ErbCpu13,
+		m.ErbCpu14,
+		m.ErbCpu15,
+		m.ErbCpu2,
+		m.ErbCpu3,
+		m.ErbCpu4,
+		m.ErbCpu5,
+		m.ErbCpu6,
+		m.ErbCpu7,
+		m.ErbCpu8,
+		m.ErbCpu9,
 		m.Events,
+		m.EventsRingbuf,
+		m.ExecMemCalls,
+		m.GoTlsReads,
 		m.PeaPerCpuArray,
+		m.RecvCalls,
 		m.ScratchSpace,
+		m.SockOwners,
+		m.SysEnterCalls,
+		m.SysExitCalls,
 		m.TarianStats,
+		m.TlsBuffers,
 	)
 }
 
@@ -152,74 +464,536 @@ func (m *tarianMaps) Close() error {
 //
 // It can be passed to loadTarianObjects or ebpf.CollectionSpec.LoadAndAssign.
 type tarianPrograms struct {
-	TdfAcceptE   *ebpf.Program `ebpf:"tdf_accept_e"`
-	TdfAcceptR   *ebpf.Program `ebpf:"tdf_accept_r"`
-	TdfBindE     *ebpf.Program `ebpf:"tdf_bind_e"`
-	TdfBindR     *ebpf.Program `ebpf:"tdf_bind_r"`
-	TdfCloneE    *ebpf.Program `ebpf:"tdf_clone_e"`
-	TdfCloneR    *ebpf.Program `ebpf:"tdf_clone_r"`
-	TdfCloseE    *ebpf.Program `ebpf:"tdf_close_e"`
-	TdfCloseR    *ebpf.Program `ebpf:"tdf_close_r"`
-	TdfConnectE  *ebpf.Program `ebpf:"tdf_connect_e"`
-	TdfConnectR  *ebpf.Program `ebpf:"tdf_connect_r"`
-	TdfExecveE   *ebpf.Program `ebpf:"tdf_execve_e"`
-	TdfExecveR   *ebpf.Program `ebpf:"tdf_execve_r"`
-	TdfExecveatE *ebpf.Program `ebpf:"tdf_execveat_e"`
-	TdfExecveatR *ebpf.Program `ebpf:"tdf_execveat_r"`
-	TdfListenE   *ebpf.Program `ebpf:"tdf_listen_e"`
-	TdfListenR   *ebpf.Program `ebpf:"tdf_listen_r"`
-	TdfOpenE     *ebpf.Program `ebpf:"tdf_open_e"`
-	TdfOpenR     *ebpf.Program `ebpf:"tdf_open_r"`
-	TdfOpenat2E  *ebpf.Program `ebpf:"tdf_openat2_e"`
-	TdfOpenat2R  *ebpf.Program `ebpf:"tdf_openat2_r"`
-	TdfOpenatE   *ebpf.Program `ebpf:"tdf_openat_e"`
-	TdfOpenatR   *ebpf.Program `ebpf:"tdf_openat_r"`
-	TdfReadE     *ebpf.Program `ebpf:"tdf_read_e"`
-	TdfReadR     *ebpf.Program `ebpf:"tdf_read_r"`
-	TdfReadvE    *ebpf.Program `ebpf:"tdf_readv_e"`
-	TdfReadvR    *ebpf.Program `ebpf:"tdf_readv_r"`
-	TdfSocketE   *ebpf.Program `ebpf:"tdf_socket_e"`
-	TdfSocketR   *ebpf.Program `ebpf:"tdf_socket_r"`
-	TdfWriteE    *ebpf.Program `ebpf:"tdf_write_e"`
-	TdfWriteR    *ebpf.Program `ebpf:"tdf_write_r"`
-	TdfWritevE   *ebpf.Program `ebpf:"tdf_writev_e"`
-	TdfWritevR   *ebpf.Program `ebpf:"tdf_writev_r"`
+	TdfAcceptE              *ebpf.Program `ebpf:"tdf_accept_e"`
+	TdfAcceptR              *ebpf.Program `ebpf:"tdf_accept_r"`
+	TdfAcceptTe             *ebpf.Program `ebpf:"tdf_accept_te"`
+	TdfAcceptTr             *ebpf.Program `ebpf:"tdf_accept_tr"`
+	TdfBindE                *ebpf.Program `ebpf:"tdf_bind_e"`
+	TdfBindR                *ebpf.Program `ebpf:"tdf_bind_r"`
+	TdfBindTe               *ebpf.Program `ebpf:"tdf_bind_te"`
+	TdfBindTr               *ebpf.Program `ebpf:"tdf_bind_tr"`
+	TdfBpfE                 *ebpf.Program `ebpf:"tdf_bpf_e"`
+	TdfBpfR                 *ebpf.Program `ebpf:"tdf_bpf_r"`
+	TdfBpfTe                *ebpf.Program `ebpf:"tdf_bpf_te"`
+	TdfBpfTr                *ebpf.Program `ebpf:"tdf_bpf_tr"`
+	TdfBprmCheckSecurity    *ebpf.Program `ebpf:"tdf_bprm_check_security"`
+	TdfCapsetE              *ebpf.Program `ebpf:"tdf_capset_e"`
+	TdfCapsetR              *ebpf.Program `ebpf:"tdf_capset_r"`
+	TdfCapsetTe             *ebpf.Program `ebpf:"tdf_capset_te"`
+	TdfCapsetTr             *ebpf.Program `ebpf:"tdf_capset_tr"`
+	TdfCgroupConnect4       *ebpf.Program `ebpf:"tdf_cgroup_connect4"`
+	TdfCgroupConnect6       *ebpf.Program `ebpf:"tdf_cgroup_connect6"`
+	TdfCgroupSendmsg4       *ebpf.Program `ebpf:"tdf_cgroup_sendmsg4"`
+	TdfCgroupSendmsg6       *ebpf.Program `ebpf:"tdf_cgroup_sendmsg6"`
+	TdfCgroupSockCreate     *ebpf.Program `ebpf:"tdf_cgroup_sock_create"`
+	TdfChmodE               *ebpf.Program `ebpf:"tdf_chmod_e"`
+	TdfChmodR               *ebpf.Program `ebpf:"tdf_chmod_r"`
+	TdfChmodTe              *ebpf.Program `ebpf:"tdf_chmod_te"`
+	TdfChmodTr              *ebpf.Program `ebpf:"tdf_chmod_tr"`
+	TdfChownE               *ebpf.Program `ebpf:"tdf_chown_e"`
+	TdfChownR               *ebpf.Program `ebpf:"tdf_chown_r"`
+	TdfChownTe              *ebpf.Program `ebpf:"tdf_chown_te"`
+	TdfChownTr              *ebpf.Program `ebpf:"tdf_chown_tr"`
+	TdfChrootE              *ebpf.Program `ebpf:"tdf_chroot_e"`
+	TdfChrootR              *ebpf.Program `ebpf:"tdf_chroot_r"`
+	TdfChrootTe             *ebpf.Program `ebpf:"tdf_chroot_te"`
+	TdfChrootTr             *ebpf.Program `ebpf:"tdf_chroot_tr"`
+	TdfClone3E              *ebpf.Program `ebpf:"tdf_clone3_e"`
+	TdfClone3R              *ebpf.Program `ebpf:"tdf_clone3_r"`
+	TdfClone3Te             *ebpf.Program `ebpf:"tdf_clone3_te"`
+	TdfClone3Tr             *ebpf.Program `ebpf:"tdf_clone3_tr"`
+	TdfCloneE               *ebpf.Program `ebpf:"tdf_clone_e"`
+	TdfCloneR               *ebpf.Program `ebpf:"tdf_clone_r"`
+	TdfCloneTe              *ebpf.Program `ebpf:"tdf_clone_te"`
+	TdfCloneTr              *ebpf.Program `ebpf:"tdf_clone_tr"`
+	TdfCloseE               *ebpf.Program `ebpf:"tdf_close_e"`
+	TdfCloseR               *ebpf.Program `ebpf:"tdf_close_r"`
+	TdfCloseTe              *ebpf.Program `ebpf:"tdf_close_te"`
+	TdfCloseTr              *ebpf.Program `ebpf:"tdf_close_tr"`
+	TdfCommitCreds          *ebpf.Program `ebpf:"tdf_commit_creds"`
+	TdfConnectE             *ebpf.Program `ebpf:"tdf_connect_e"`
+	TdfConnectR             *ebpf.Program `ebpf:"tdf_connect_r"`
+	TdfConnectTe            *ebpf.Program `ebpf:"tdf_connect_te"`
+	TdfConnectTr            *ebpf.Program `ebpf:"tdf_connect_tr"`
+	TdfDeleteModuleE        *ebpf.Program `ebpf:"tdf_delete_module_e"`
+	TdfDeleteModuleR        *ebpf.Program `ebpf:"tdf_delete_module_r"`
+	TdfDeleteModuleTe       *ebpf.Program `ebpf:"tdf_delete_module_te"`
+	TdfDeleteModuleTr       *ebpf.Program `ebpf:"tdf_delete_module_tr"`
+	TdfDoInitModule         *ebpf.Program `ebpf:"tdf_do_init_module"`
+	TdfExecveE              *ebpf.Program `ebpf:"tdf_execve_e"`
+	TdfExecveR              *ebpf.Program `ebpf:"tdf_execve_r"`
+	TdfExecveTe             *ebpf.Program `ebpf:"tdf_execve_te"`
+	TdfExecveTr             *ebpf.Program `ebpf:"tdf_execve_tr"`
+	TdfExecveatE            *ebpf.Program `ebpf:"tdf_execveat_e"`
+	TdfExecveatR            *ebpf.Program `ebpf:"tdf_execveat_r"`
+	TdfExecveatTe           *ebpf.Program `ebpf:"tdf_execveat_te"`
+	TdfExecveatTr           *ebpf.Program `ebpf:"tdf_execveat_tr"`
+	TdfFchmodatE            *ebpf.Program `ebpf:"tdf_fchmodat_e"`
+	TdfFchmodatR            *ebpf.Program `ebpf:"tdf_fchmodat_r"`
+	TdfFchmodatTe           *ebpf.Program `ebpf:"tdf_fchmodat_te"`
+	TdfFchmodatTr           *ebpf.Program `ebpf:"tdf_fchmodat_tr"`
+	TdfFchownatE            *ebpf.Program `ebpf:"tdf_fchownat_e"`
+	TdfFchownatR            *ebpf.Program `ebpf:"tdf_fchownat_r"`
+	TdfFchownatTe           *ebpf.Program `ebpf:"tdf_fchownat_te"`
+	TdfFchownatTr           *ebpf.Program `ebpf:"tdf_fchownat_tr"`
+	TdfFileOpen             *ebpf.Program `ebpf:"tdf_file_open"`
+	TdfFinitModuleE         *ebpf.Program `ebpf:"tdf_finit_module_e"`
+	TdfFinitModuleR         *ebpf.Program `ebpf:"tdf_finit_module_r"`
+	TdfFinitModuleTe        *ebpf.Program `ebpf:"tdf_finit_module_te"`
+	TdfFinitModuleTr        *ebpf.Program `ebpf:"tdf_finit_module_tr"`
+	TdfForkE                *ebpf.Program `ebpf:"tdf_fork_e"`
+	TdfForkR                *ebpf.Program `ebpf:"tdf_fork_r"`
+	TdfForkTe               *ebpf.Program `ebpf:"tdf_fork_te"`
+	TdfForkTr               *ebpf.Program `ebpf:"tdf_fork_tr"`
+	TdfFtruncateE           *ebpf.Program `ebpf:"tdf_ftruncate_e"`
+	TdfFtruncateR           *ebpf.Program `ebpf:"tdf_ftruncate_r"`
+	TdfFtruncateTe          *ebpf.Program `ebpf:"tdf_ftruncate_te"`
+	TdfFtruncateTr          *ebpf.Program `ebpf:"tdf_ftruncate_tr"`
+	TdfGotlsReadE           *ebpf.Program `ebpf:"tdf_gotls_read_e"`
+	TdfGotlsReadR           *ebpf.Program `ebpf:"tdf_gotls_read_r"`
+	TdfGotlsWriteE          *ebpf.Program `ebpf:"tdf_gotls_write_e"`
+	TdfInetCskAccept        *ebpf.Program `ebpf:"tdf_inet_csk_accept"`
+	TdfInitModuleE          *ebpf.Program `ebpf:"tdf_init_module_e"`
+	TdfInitModuleR          *ebpf.Program `ebpf:"tdf_init_module_r"`
+	TdfInitModuleTe         *ebpf.Program `ebpf:"tdf_init_module_te"`
+	TdfInitModuleTr         *ebpf.Program `ebpf:"tdf_init_module_tr"`
+	TdfLinkE                *ebpf.Program `ebpf:"tdf_link_e"`
+	TdfLinkR                *ebpf.Program `ebpf:"tdf_link_r"`
+	TdfLinkTe               *ebpf.Program `ebpf:"tdf_link_te"`
+	TdfLinkTr               *ebpf.Program `ebpf:"tdf_link_tr"`
+	TdfLinkatE              *ebpf.Program `ebpf:"tdf_linkat_e"`
+	TdfLinkatR              *ebpf.Program `ebpf:"tdf_linkat_r"`
+	TdfLinkatTe             *ebpf.Program `ebpf:"tdf_linkat_te"`
+	TdfLinkatTr             *ebpf.Program `ebpf:"tdf_linkat_tr"`
+	TdfListenE              *ebpf.Program `ebpf:"tdf_listen_e"`
+	TdfListenR              *ebpf.Program `ebpf:"tdf_listen_r"`
+	TdfListenTe             *ebpf.Program `ebpf:"tdf_listen_te"`
+	TdfListenTr             *ebpf.Program `ebpf:"tdf_listen_tr"`
+	TdfMemfdCreateE         *ebpf.Program `ebpf:"tdf_memfd_create_e"`
+	TdfMemfdCreateR         *ebpf.Program `ebpf:"tdf_memfd_create_r"`
+	TdfMemfdCreateTe        *ebpf.Program `ebpf:"tdf_memfd_create_te"`
+	TdfMemfdCreateTr        *ebpf.Program `ebpf:"tdf_memfd_create_tr"`
+	TdfMmapE                *ebpf.Program `ebpf:"tdf_mmap_e"`
+	TdfMmapR                *ebpf.Program `ebpf:"tdf_mmap_r"`
+	TdfMmapTe               *ebpf.Program `ebpf:"tdf_mmap_te"`
+	TdfMmapTr               *ebpf.Program `ebpf:"tdf_mmap_tr"`
+	TdfMountE               *ebpf.Program `ebpf:"tdf_mount_e"`
+	TdfMountR               *ebpf.Program `ebpf:"tdf_mount_r"`
+	TdfMountTe              *ebpf.Program `ebpf:"tdf_mount_te"`
+	TdfMountTr              *ebpf.Program `ebpf:"tdf_mount_tr"`
+	TdfMprotectE            *ebpf.Program `ebpf:"tdf_mprotect_e"`
+	TdfMprotectR            *ebpf.Program `ebpf:"tdf_mprotect_r"`
+	TdfMprotectTe           *ebpf.Program `ebpf:"tdf_mprotect_te"`
+	TdfMprotectTr           *ebpf.Program `ebpf:"tdf_mprotect_tr"`
+	TdfOpenE                *ebpf.Program `ebpf:"tdf_open_e"`
+	TdfOpenR                *ebpf.Program `ebpf:"tdf_open_r"`
+	TdfOpenTe               *ebpf.Program `ebpf:"tdf_open_te"`
+	TdfOpenTr               *ebpf.Program `ebpf:"tdf_open_tr"`
+	TdfOpenat2E             *ebpf.Program `ebpf:"tdf_openat2_e"`
+	TdfOpenat2R             *ebpf.Program `ebpf:"tdf_openat2_r"`
+	TdfOpenat2Te            *ebpf.Program `ebpf:"tdf_openat2_te"`
+	TdfOpenat2Tr            *ebpf.Program `ebpf:"tdf_openat2_tr"`
+	TdfOpenatE              *ebpf.Program `ebpf:"tdf_openat_e"`
+	TdfOpenatR              *ebpf.Program `ebpf:"tdf_openat_r"`
+	TdfOpenatTe             *ebpf.Program `ebpf:"tdf_openat_te"`
+	TdfOpenatTr             *ebpf.Program `ebpf:"tdf_openat_tr"`
+	TdfPivotRootE           *ebpf.Program `ebpf:"tdf_pivot_root_e"`
+	TdfPivotRootR           *ebpf.Program `ebpf:"tdf_pivot_root_r"`
+	TdfPivotRootTe          *ebpf.Program `ebpf:"tdf_pivot_root_te"`
+	TdfPivotRootTr          *ebpf.Program `ebpf:"tdf_pivot_root_tr"`
+	TdfProcessVmReadvE      *ebpf.Program `ebpf:"tdf_process_vm_readv_e"`
+	TdfProcessVmReadvR      *ebpf.Program `ebpf:"tdf_process_vm_readv_r"`
+	TdfProcessVmReadvTe     *ebpf.Program `ebpf:"tdf_process_vm_readv_te"`
+	TdfProcessVmReadvTr     *ebpf.Program `ebpf:"tdf_process_vm_readv_tr"`
+	TdfProcessVmWritevE     *ebpf.Program `ebpf:"tdf_process_vm_writev_e"`
+	TdfProcessVmWritevR     *ebpf.Program `ebpf:"tdf_process_vm_writev_r"`
+	TdfProcessVmWritevTe    *ebpf.Program `ebpf:"tdf_process_vm_writev_te"`
+	TdfProcessVmWritevTr    *ebpf.Program `ebpf:"tdf_process_vm_writev_tr"`
+	TdfPtraceE              *ebpf.Program `ebpf:"tdf_ptrace_e"`
+	TdfPtraceR              *ebpf.Program `ebpf:"tdf_ptrace_r"`
+	TdfPtraceTe             *ebpf.Program `ebpf:"tdf_ptrace_te"`
+	TdfPtraceTr             *ebpf.Program `ebpf:"tdf_ptrace_tr"`
+	TdfReadE                *ebpf.Program `ebpf:"tdf_read_e"`
+	TdfReadR                *ebpf.Program `ebpf:"tdf_read_r"`
+	TdfReadTe               *ebpf.Program `ebpf:"tdf_read_te"`
+	TdfReadTr               *ebpf.Program `ebpf:"tdf_read_tr"`
+	TdfReadvE               *ebpf.Program `ebpf:"tdf_readv_e"`
+	TdfReadvR               *ebpf.Program `ebpf:"tdf_readv_r"`
+	TdfReadvTe              *ebpf.Program `ebpf:"tdf_readv_te"`
+	TdfReadvTr              *ebpf.Program `ebpf:"tdf_readv_tr"`
+	TdfRecvfromE            *ebpf.Program `ebpf:"tdf_recvfrom_e"`
+	TdfRecvfromR            *ebpf.Program `ebpf:"tdf_recvfrom_r"`
+	TdfRecvfromTe           *ebpf.Program `ebpf:"tdf_recvfrom_te"`
+	TdfRecvfromTr           *ebpf.Program `ebpf:"tdf_recvfrom_tr"`
+	TdfRecvmsgE             *ebpf.Program `ebpf:"tdf_recvmsg_e"`
+	TdfRecvmsgR             *ebpf.Program `ebpf:"tdf_recvmsg_r"`
+	TdfRecvmsgTe            *ebpf.Program `ebpf:"tdf_recvmsg_te"`
+	TdfRecvmsgTr            *ebpf.Program `ebpf:"tdf_recvmsg_tr"`
+	TdfRenameE              *ebpf.Program `ebpf:"tdf_rename_e"`
+	TdfRenameR              *ebpf.Program `ebpf:"tdf_rename_r"`
+	TdfRenameTe             *ebpf.Program `ebpf:"tdf_rename_te"`
+	TdfRenameTr             *ebpf.Program `ebpf:"tdf_rename_tr"`
+	TdfRenameat2E           *ebpf.Program `ebpf:"tdf_renameat2_e"`
+	TdfRenameat2R           *ebpf.Program `ebpf:"tdf_renameat2_r"`
+	TdfRenameat2Te          *ebpf.Program `ebpf:"tdf_renameat2_te"`
+	TdfRenameat2Tr          *ebpf.Program `ebpf:"tdf_renameat2_tr"`
+	TdfSecurityFileMprotect *ebpf.Program `ebpf:"tdf_security_file_mprotect"`
+	TdfSendmsgE             *ebpf.Program `ebpf:"tdf_sendmsg_e"`
+	TdfSendmsgR             *ebpf.Program `ebpf:"tdf_sendmsg_r"`
+	TdfSendmsgTe            *ebpf.Program `ebpf:"tdf_sendmsg_te"`
+	TdfSendmsgTr            *ebpf.Program `ebpf:"tdf_sendmsg_tr"`
+	TdfSendtoE              *ebpf.Program `ebpf:"tdf_sendto_e"`
+	TdfSendtoR              *ebpf.Program `ebpf:"tdf_sendto_r"`
+	TdfSendtoTe             *ebpf.Program `ebpf:"tdf_sendto_te"`
+	TdfSendtoTr             *ebpf.Program `ebpf:"tdf_sendto_tr"`
+	TdfSetfsuidE            *ebpf.Program `ebpf:"tdf_setfsuid_e"`
+	TdfSetfsuidR            *ebpf.Program `ebpf:"tdf_setfsuid_r"`
+	TdfSetfsuidTe           *ebpf.Program `ebpf:"tdf_setfsuid_te"`
+	TdfSetfsuidTr           *ebpf.Program `ebpf:"tdf_setfsuid_tr"`
+	TdfSetgidE              *ebpf.Program `ebpf:"tdf_setgid_e"`
+	TdfSetgidR              *ebpf.Program `ebpf:"tdf_setgid_r"`
+	TdfSetgidTe             *ebpf.Program `ebpf:"tdf_setgid_te"`
+	TdfSetgidTr             *ebpf.Program `ebpf:"tdf_setgid_tr"`
+	TdfSetnsE               *ebpf.Program `ebpf:"tdf_setns_e"`
+	TdfSetnsR               *ebpf.Program `ebpf:"tdf_setns_r"`
+	TdfSetnsTe              *ebpf.Program `ebpf:"tdf_setns_te"`
+	TdfSetnsTr              *ebpf.Program `ebpf:"tdf_setns_tr"`
+	TdfSetresgidE           *ebpf.Program `ebpf:"tdf_setresgid_e"`
+	TdfSetresgidR           *ebpf.Program `ebpf:"tdf_setresgid_r"`
+	TdfSetresgidTe          *ebpf.Program `ebpf:"tdf_setresgid_te"`
+	TdfSetresgidTr          *ebpf.Program `ebpf:"tdf_setresgid_tr"`
+	TdfSetresuidE           *ebpf.Program `ebpf:"tdf_setresuid_e"`
+	TdfSetresuidR           *ebpf.Program `ebpf:"tdf_setresuid_r"`
+	TdfSetresuidTe          *ebpf.Program `ebpf:"tdf_setresuid_te"`
+	TdfSetresuidTr          *ebpf.Program `ebpf:"tdf_setresuid_tr"`
+	TdfSetreuidE            *ebpf.Program `ebpf:"tdf_setreuid_e"`
+	TdfSetreuidR            *ebpf.Program `ebpf:"tdf_setreuid_r"`
+	TdfSetreuidTe           *ebpf.Program `ebpf:"tdf_setreuid_te"`
+	TdfSetreuidTr           *ebpf.Program `ebpf:"tdf_setreuid_tr"`
+	TdfSetuidE              *ebpf.Program `ebpf:"tdf_setuid_e"`
+	TdfSetuidR              *ebpf.Program `ebpf:"tdf_setuid_r"`
+	TdfSetuidTe             *ebpf.Program `ebpf:"tdf_setuid_te"`
+	TdfSetuidTr             *ebpf.Program `ebpf:"tdf_setuid_tr"`
+	TdfSocketConnect        *ebpf.Program `ebpf:"tdf_socket_connect"`
+	TdfSocketE              *ebpf.Program `ebpf:"tdf_socket_e"`
+	TdfSocketR              *ebpf.Program `ebpf:"tdf_socket_r"`
+	TdfSocketTe             *ebpf.Program `ebpf:"tdf_socket_te"`
+	TdfSocketTr             *ebpf.Program `ebpf:"tdf_socket_tr"`
+	TdfSslE                 *ebpf.Program `ebpf:"tdf_ssl_e"`
+	TdfSslReadR             *ebpf.Program `ebpf:"tdf_ssl_read_r"`
+	TdfSslWriteR            *ebpf.Program `ebpf:"tdf_ssl_write_r"`
+	TdfSymlinkE             *ebpf.Program `ebpf:"tdf_symlink_e"`
+	TdfSymlinkR             *ebpf.Program `ebpf:"tdf_symlink_r"`
+	TdfSymlinkTe            *ebpf.Program `ebpf:"tdf_symlink_te"`
+	TdfSymlinkTr            *ebpf.Program `ebpf:"tdf_symlink_tr"`
+	TdfSymlinkatE           *ebpf.Program `ebpf:"tdf_symlinkat_e"`
+	TdfSymlinkatR           *ebpf.Program `ebpf:"tdf_symlinkat_r"`
+	TdfSymlinkatTe          *ebpf.Program `ebpf:"tdf_symlinkat_te"`
+	TdfSymlinkatTr          *ebpf.Program `ebpf:"tdf_symlinkat_tr"`
+	TdfSysEnter             *ebpf.Program `ebpf:"tdf_sys_enter"`
+	TdfSysExit              *ebpf.Program `ebpf:"tdf_sys_exit"`
+	TdfTcpClose             *ebpf.Program `ebpf:"tdf_tcp_close"`
+	TdfTcpConnect           *ebpf.Program `ebpf:"tdf_tcp_connect"`
+	TdfTcpSetState          *ebpf.Program `ebpf:"tdf_tcp_set_state"`
+	TdfTruncateE            *ebpf.Program `ebpf:"tdf_truncate_e"`
+	TdfTruncateR            *ebpf.Program `ebpf:"tdf_truncate_r"`
+	TdfTruncateTe           *ebpf.Program `ebpf:"tdf_truncate_te"`
+	TdfTruncateTr           *ebpf.Program `ebpf:"tdf_truncate_tr"`
+	TdfUmountE              *ebpf.Program `ebpf:"tdf_umount_e"`
+	TdfUmountR              *ebpf.Program `ebpf:"tdf_umount_r"`
+	TdfUmountTe             *ebpf.Program `ebpf:"tdf_umount_te"`
+	TdfUmountTr             *ebpf.Program `ebpf:"tdf_umount_tr"`
+	TdfUnlinkE              *ebpf.Program `ebpf:"tdf_unlink_e"`
+	TdfUnlinkR              *ebpf.Program `ebpf:"tdf_unlink_r"`
+	TdfUnlinkTe             *ebpf.Program `ebpf:"tdf_unlink_te"`
+	TdfUnlinkTr             *ebpf.Program `ebpf:"tdf_unlink_tr"`
+	TdfUnlinkatE            *ebpf.Program `ebpf:"tdf_unlinkat_e"`
+	TdfUnlinkatR            *ebpf.Program `ebpf:"tdf_unlinkat_r"`
+	TdfUnlinkatTe           *ebpf.Program `ebpf:"tdf_unlinkat_te"`
+	TdfUnlinkatTr           *ebpf.Program `ebpf:"tdf_unlinkat_tr"`
+	TdfUnshareE             *ebpf.Program `ebpf:"tdf_unshare_e"`
+	TdfUnshareR             *ebpf.Program `ebpf:"tdf_unshare_r"`
+	TdfUnshareTe            *ebpf.Program `ebpf:"tdf_unshare_te"`
+	TdfUnshareTr            *ebpf.Program `ebpf:"tdf_unshare_tr"`
+	TdfVforkE               *ebpf.Program `ebpf:"tdf_vfork_e"`
+	TdfVforkR               *ebpf.Program `ebpf:"tdf_vfork_r"`
+	TdfVforkTe              *ebpf.Program `ebpf:"tdf_vfork_te"`
+	TdfVforkTr              *ebpf.Program `ebpf:"tdf_vfork_tr"`
+	TdfWriteE               *ebpf.Program `ebpf:"tdf_write_e"`
+	TdfWriteR               *ebpf.Program `ebpf:"tdf_write_r"`
+	TdfWriteTe              *ebpf.Program `ebpf:"tdf_write_te"`
+	TdfWriteTr              *ebpf.Program `ebpf:"tdf_write_tr"`
+	TdfWritevE              *ebpf.Program `ebpf:"tdf_writev_e"`
+	TdfWritevR              *ebpf.Program `ebpf:"tdf_writev_r"`
+	TdfWritevTe             *ebpf.Program `ebpf:"tdf_writev_te"`
+	TdfWritevTr             *ebpf.Program `ebpf:"tdf_writev_tr"`
 }
 
 func (p *tarianPrograms) Close() error {
 	return _TarianClose(
 		p.TdfAcceptE,
 		p.TdfAcceptR,
+		p.TdfAcceptTe,
+		p.TdfAcceptTr,
 		p.TdfBindE,
 		p.TdfBindR,
+		p.TdfBindTe,
+		p.TdfBindTr,
+		p.TdfBpfE,
+		p.TdfBpfR,
+		p.TdfBpfTe,
+		p.TdfBpfTr,
+		p.TdfBprmCheckSecurity,
+		p.TdfCapsetE,
+		p.TdfCapsetR,
+		p.TdfCapsetTe,
+		p.TdfCapsetTr,
+		p.TdfCgroupConnect4,
+		p.TdfCgroupConnect6,
+		p.TdfCgroupSendmsg4,
+		p.TdfCgroupSendmsg6,
+		p.TdfCgroupSockCreate,
+		p.TdfChmodE,
+		p.TdfChmodR,
+		p.TdfChmodTe,
+		p.TdfChmodTr,
+		p.TdfChownE,
+		p.TdfChownR,
+		p.TdfChownTe,
+		p.TdfChownTr,
+		p.TdfChrootE,
+		p.TdfChrootR,
+		p.TdfChrootTe,
+		p.TdfChrootTr,
+		p.TdfClone3E,
+		p.TdfClone3R,
+		p.TdfClone3Te,
+		p.TdfClone3Tr,
 		p.TdfCloneE,
 		p.TdfCloneR,
+		p.TdfCloneTe,
+		p.TdfCloneTr,
 		p.TdfCloseE,
 		p.TdfCloseR,
+		p.TdfCloseTe,
+		p.TdfCloseTr,
+		p.TdfCommitCreds,
 		p.TdfConnectE,
 		p.TdfConnectR,
+		p.TdfConnectTe,
+		p.TdfConnectTr,
+		p.TdfDeleteModuleE,
+		p.TdfDeleteModuleR,
+		p.TdfDeleteModuleTe,
+		p.TdfDeleteModuleTr,
+		p.TdfDoInitModule,
 		p.TdfExecveE,
 		p.TdfExecveR,
+		p.TdfExecveTe,
+		p.TdfExecveTr,
 		p.TdfExecveatE,
 		p.TdfExecveatR,
+		p.TdfExecveatTe,
+		p.TdfExecveatTr,
+		p.TdfFchmodatE,
+		p.TdfFchmodatR,
+		p.TdfFchmodatTe,
+		p.TdfFchmodatTr,
+		p.TdfFchownatE,
+		p.TdfFchownatR,
+		p.TdfFchownatTe,
+		p.TdfFchownatTr,
+		p.TdfFileOpen,
+		p.TdfFinitModuleE,
+		p.TdfFinitModuleR,
+		p.TdfFinitModuleTe,
+		p.TdfFinitModuleTr,
+		p.TdfForkE,
+		p.TdfForkR,
+		p.TdfForkTe,
+		p.TdfForkTr,
+		p.TdfFtruncateE,
+		p.TdfFtruncateR,
+		p.TdfFtruncateTe,
+		p.TdfFtruncateTr,
+		p.TdfGotlsReadE,
+		p.TdfGotlsReadR,
+		p.TdfGotlsWriteE,
+		p.TdfInetCskAccept,
+		p.TdfInitModuleE,
+		p.TdfInitModuleR,
+		p.TdfInitModuleTe,
+		p.TdfInitModuleTr,
+		p.TdfLinkE,
+		p.TdfLinkR,
+		p.TdfLinkTe,
+		p.TdfLinkTr,
+		p.TdfLinkatE,
+		p.TdfLinkatR,
+		p.TdfLinkatTe,
+		p.TdfLinkatTr,
 		p.TdfListenE,
 		p.TdfListenR,
+		p.TdfListenTe,
+		p.TdfListenTr,
+		p.TdfMemfdCreateE,
+		p.TdfMemfdCreateR,
+		p.TdfMemfdCreateTe,
+		p.TdfMemfdCreateTr,
+		p.TdfMmapE,
+		p.TdfMmapR,
+		p.TdfMmapTe,
+		p.TdfMmapTr,
+		p.TdfMountE,
+		p.TdfMountR,
+		p.TdfMountTe,
+		p.TdfMountTr,
+		p.TdfMprotectE,
+		p.TdfMprotectR,
+		p.TdfMprotectTe,
+		p.TdfMprotectTr,
 		p.TdfOpenE,
 		p.TdfOpenR,
+		p.TdfOpenTe,
+		p.TdfOpenTr,
 		p.TdfOpenat2E,
 		p.TdfOpenat2R,
+		p.TdfOpenat2Te,
+		p.TdfOpenat2Tr,
 		p.TdfOpenatE,
 		p.TdfOpenatR,
+		p.TdfOpenatTe,
+		p.TdfOpenatTr,
+		p.TdfPivotRootE,
+		p.TdfPivotRootR,
+		p.TdfPivotRootTe,
+		p.TdfPivotRootTr,
+		p.TdfProcessVmReadvE,
+		p.TdfProcessVmReadvR,
+		p.TdfProcessVmReadvTe,
+		p.TdfProcessVmReadvTr,
+		p.TdfProcessVmWritevE,
+		p.TdfProcessVmWritevR,
+		p.TdfProcessVmWritevTe,
+		p.TdfProcessVmWritevTr,
+		p.TdfPtraceE,
+		p.TdfPtraceR,
+		p.TdfPtraceTe,
+		p.TdfPtraceTr,
 		p.TdfReadE,
 		p.TdfReadR,
+		p.TdfReadTe,
+		p.TdfReadTr,
 		p.TdfReadvE,
 		p.TdfReadvR,
+		p.TdfReadvTe,
+		p.TdfReadvTr,
+		p.TdfRecvfromE,
+		p.TdfRecvfromR,
+		p.TdfRecvfromTe,
+		p.TdfRecvfromTr,
+		p.TdfRecvmsgE,
+		p.TdfRecvmsgR,
+		p.TdfRecvmsgTe,
+		p.TdfRecvmsgTr,
+		p.TdfRenameE,
+		p.TdfRenameR,
+		p.TdfRenameTe,
+		p.TdfRenameTr,
+		p.TdfRenameat2E,
+		p.TdfRenameat2R,
+		p.TdfRenameat2Te,
+		p.TdfRenameat2Tr,
+		p.TdfSecurityFileMprotect,
+		p.TdfSendmsgE,
+		p.TdfSendmsgR,
+		p.TdfSendmsgTe,
+		p.TdfSendmsgTr,
+		p.TdfSendtoE,
+		p.TdfSendtoR,
+		p.TdfSendtoTe,
+		p.TdfSendtoTr,
+		p.TdfSetfsuidE,
+		p.TdfSetfsuidR,
+		p.TdfSetfsuidTe,
+		p.TdfSetfsuidTr,
+		p.TdfSetgidE,
+		p.TdfSetgidR,
+		p.TdfSetgidTe,
+		p.TdfSetgidTr,
+		p.TdfSetnsE,
+		p.TdfSetnsR,
+		p.TdfSetnsTe,
+		p.TdfSetnsTr,
+		p.TdfSetresgidE,
+		p.TdfSetresgidR,
+		p.TdfSetresgidTe,
+		p.TdfSetresgidTr,
+		p.TdfSetresuidE,
+		p.TdfSetresuidR,
+		p.TdfSetresuidTe,
+		p.TdfSetresuidTr,
+		p.TdfSetreuidE,
+		p.TdfSetreuidR,
+		p.TdfSetreuidTe,
+		p.TdfSetreuidTr,
+		p.TdfSetuidE,
+		p.TdfSetuidR,
+		p.TdfSetuidTe,
+		p.TdfSetuidTr,
+		p.TdfSocketConnect,
 		p.TdfSocketE,
 		p.TdfSocketR,
+		p.TdfSocketTe,
+		p.TdfSocketTr,
+		p.TdfSslE,
+		p.TdfSslReadR,
+		p.TdfSslWriteR,
+		p.TdfSymlinkE,
+		p.TdfSymlinkR,
+		p.TdfSymlinkTe,
+		p.TdfSymlinkTr,
+		p.TdfSymlinkatE,
+		p.TdfSymlinkatR,
+		p.TdfSymlinkatTe,
+		p.TdfSymlinkatTr,
+		p.TdfSysEnter,
+		p.TdfSysExit,
+		p.TdfTcpClose,
+		p.TdfTcpConnect,
+		p.TdfTcpSetState,
+		p.TdfTruncateE,
+		p.TdfTruncateR,
+		p.TdfTruncateTe,
+		p.TdfTruncateTr,
+		p.TdfUmountE,
+		p.TdfUmountR,
+		p.TdfUmountTe,
+		p.TdfUmountTr,
+		p.TdfUnlinkE,
+		p.TdfUnlinkR,
+		p.TdfUnlinkTe,
+		p.TdfUnlinkTr,
+		p.TdfUnlinkatE,
+		p.TdfUnlinkatR,
+		p.TdfUnlinkatTe,
+		p.TdfUnlinkatTr,
+		p.TdfUnshareE,
+		p.TdfUnshareR,
+		p.TdfUnshareTe,
+		p.TdfUnshareTr,
+		p.TdfVforkE,
+		p.TdfVforkR,
+		p.TdfVforkTe,
+		p.TdfVforkTr,
 		p.TdfWriteE,
 		p.TdfWriteR,
+		p.TdfWriteTe,
+		p.TdfWriteTr,
 		p.TdfWritevE,
 		p.TdfWritevR,
+		p.TdfWritevTe,
+		p.TdfWritevTr,
 	)
 }
 
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"debug/elf"
+	"slices"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+	"github.com/intelops/tarian-detector/pkg/err"
+	"golang.org/x/arch/arm64/arm64asm"
+	"golang.org/x/arch/x86/x86asm"
+)
+
+var tlsErr = err.New("tarian.tls")
+
+// tlsMaxDataConstant is the read-only variable of the eBPF programs limiting the captured plaintext.
+const tlsMaxDataConstant = "tarian_tls_max_data"
+
+// MaxTLSData is the maximum number of plaintext bytes captured per TLS read or write.
+const MaxTLSData = 4096
+
+// Symbols hooked in the TLS libraries.
+const (
+	sslWriteSymbol   = "SSL_write"
+	sslReadSymbol    = "SSL_read"
+	goTLSWriteSymbol = "crypto/tls.(*Conn).Write"
+	goTLSReadSymbol  = "crypto/tls.(*Conn).Read"
+)
+
+// tlsLibrary is the TLS implementation found in an executable.
+type tlsLibrary int
+
+const (
+	noTLS tlsLibrary = iota
+	// openSSL is OpenSSL or BoringSSL, linked statically or the libssl shared object itself.
+	openSSL
+	// goTLS is the crypto/tls package of the Go standard library. Uretprobes corrupt the stacks moved
+	// by the Go runtime, so the reads are reported by uprobes on the RET instructions of Read.
+	goTLS
+)
+
+// String returns the name of the TLS library.
+func (l tlsLibrary) String() string {
+	switch l {
+	case openSSL:
+		return "openssl"
+	case goTLS:
+		return "go"
+	default:
+		return "none"
+	}
+}
+
+// detectTLSLibrary returns the TLS library whose symbols are exported or kept by the executable at path.
+func detectTLSLibrary(path string) (tlsLibrary, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return noTLS, tlsErr.Throwf("%v", err)
+	}
+	defer f.Close()
+
+	var names []string
+	for _, read := range []func() ([]elf.Symbol, error){f.DynamicSymbols, f.Symbols} {
+		syms, err := read()
+		if err != nil {
+			continue
+		}
+
+		for _, sym := range syms {
+			names = append(names, sym.Name)
+		}
+	}
+
+	switch {
+	case slices.Contains(names, sslWriteSymbol) && slices.Contains(names, sslReadSymbol):
+		return openSSL, nil
+	case slices.Contains(names, goTLSWriteSymbol):
+		return goTLS, nil
+	default:
+		return noTLS, tlsErr.Throwf("%s: no supported TLS library found", path)
+	}
+}
+
+// tlsHook pairs a hook of a TLS library with the name of the program attached to it.
+type tlsHook struct {
+	program string
+	hook    *ebpf.HookInfo
+}
+
+// tlsHooks returns the hooks capturing the plaintext of the TLS library in the executable at path.
+// readReturns are the offsets of the RET instructions of the Go Read function, see returnOffsets.
+func tlsHooks(library tlsLibrary, path string, readReturns []uint64) []tlsHook {
+	switch library {
+	case openSSL:
+		return []tlsHook{
+			{"tdf_ssl_e", ebpf.NewHookInfo().Uprobe(path, sslWriteSymbol)},
+			{"tdf_ssl_write_r", ebpf.NewHookInfo().Uretprobe(path, sslWriteSymbol)},
+			{"tdf_ssl_e", ebpf.NewHookInfo().Uprobe(path, sslReadSymbol)},
+			{"tdf_ssl_read_r", ebpf.NewHookInfo().Uretprobe(path, sslReadSymbol)},
+		}
+	case goTLS:
+		hooks := []tlsHook{
+			{"tdf_gotls_write_e", ebpf.NewHookInfo().Uprobe(path, goTLSWriteSymbol)},
+			{"tdf_gotls_read_e", ebpf.NewHookInfo().Uprobe(path, goTLSReadSymbol)},
+		}
+
+		for _, off := range readReturns {
+			hooks = append(hooks, tlsHook{"tdf_gotls_read_r", ebpf.NewHookInfo().Uprobe(path, goTLSReadSymbol, &link.UprobeOptions{Offset: off})})
+		}
+
+		return hooks
+	default:
+		return nil
+	}
+}
+
+// tlsPrograms pairs the loaded TLS programs with the hooks of the executable at path.
+func tlsPrograms(objs *tarianPrograms, path string) ([]*ebpf.ProgramInfo, error) {
+	library, err := detectTLSLibrary(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var readReturns []uint64
+	if library == goTLS {
+		readReturns, err = returnOffsets(path, goTLSReadSymbol)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var progs []*ebpf.ProgramInfo
+	for _, th := range tlsHooks(library, path, readReturns) {
+		prog := objs.tlsProgram(th.program)
+		if prog == nil {
+			return nil, tlsErr.Throwf("missing program %s for %s", th.program, path)
+		}
+
+		progs = append(progs, ebpf.NewProgram(prog, th.hook))
+	}
+
+	return progs, nil
+}
+
+// returnOffsets disassembles the function symbol of the executable at path and returns the offsets
+// of its RET instructions, relative to the symbol.
+func returnOffsets(path string, symbol string) ([]uint64, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, tlsErr.Throwf("%v", err)
+	}
+	defer f.Close()
+
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, tlsErr.Throwf("%s: %v", path, err)
+	}
+
+	i := slices.IndexFunc(syms, func(sym elf.Symbol) bool { return sym.Name == symbol })
+	if i < 0 {
+		return nil, tlsErr.Throwf("%s: symbol %s not found", path, symbol)
+	}
+
+	sym := syms[i]
+	for _, sec := range f.Sections {
+		if sec.Type != elf.SHT_PROGBITS || sym.Value < sec.Addr || sym.Value+sym.Size > sec.Addr+sec.Size {
+			continue
+		}
+
+		code := make([]byte, sym.Size)
+		if _, err := sec.ReadAt(code, int64(sym.Value-sec.Addr)); err != nil {
+			return nil, tlsErr.Throwf("%s: reading %s: %v", path, symbol, err)
+		}
+
+		return decodeReturns(f.Machine, code)
+	}
+
+	return nil, tlsErr.Throwf("%s: no section holds the code of %s", path, symbol)
+}
+
+// decodeReturns returns the offsets of the RET instructions of the machine code.
+func decodeReturns(machine elf.Machine, code []byte) ([]uint64, error) {
+	var offsets []uint64
+	switch machine {
+	case elf.EM_X86_64:
+		for pos := 0; pos < len(code); {
+			inst, err := x86asm.Decode(code[pos:], 64)
+			if err != nil {
+				return nil, tlsErr.Throwf("decoding the instruction at %#x: %v", pos, err)
+			}
+
+			if inst.Op == x86asm.RET {
+				offsets = append(offsets, uint64(pos))
+			}
+
+			pos += inst.Len
+		}
+	case elf.EM_AARCH64:
+		for pos := 0; pos+4 <= len(code); pos += 4 {
+			// the padding between functions does not decode
+			inst, err := arm64asm.Decode(code[pos:])
+			if err == nil && inst.Op == arm64asm.RET {
+				offsets = append(offsets, uint64(pos))
+			}
+		}
+	default:
+		return nil, tlsErr.Throwf("unsupported machine %v", machine)
+	}
+
+	if len(offsets) == 0 {
+		return nil, tlsErr.Throw("no RET instruction found")
+	}
+
+	return offsets, nil
+}
+
+// tlsProgram returns the loaded TLS program with the given name or nil if there is none.
+func (p *tarianPrograms) tlsProgram(name string) *cilium_ebpf.Program {
+	switch name {
+	case "tdf_ssl_e":
+		return p.TdfSslE
+	case "tdf_ssl_write_r":
+		return p.TdfSslWriteR
+	case "tdf_ssl_read_r":
+		return p.TdfSslReadR
+	case "tdf_gotls_write_e":
+		return p.TdfGotlsWriteE
+	case "tdf_gotls_read_e":
+		return p.TdfGotlsReadE
+	case "tdf_gotls_read_r":
+		return p.TdfGotlsReadR
+	default:
+		return nil
+	}
+}
+
+// tlsMaxData returns the value of the read-only variable limiting the captured plaintext.
+func tlsMaxData(n uint32) (uint32, error) {
+	if n == 0 {
+		return MaxTLSData, nil
+	}
+
+	if n > MaxTLSData {
+		return 0, tlsErr.Throwf("at most %d bytes of TLS plaintext can be captured, got %d", MaxTLSData, n)
+	}
+
+	return n, nil
+}
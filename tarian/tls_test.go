@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package tarian
+
+import (
+	"debug/elf"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	cilium_ebpf "github.com/cilium/ebpf"
+	ebpf "github.com/intelops/tarian-detector/pkg/eBPF"
+)
+
+// TestDetectTLSLibrary tests the detectTLSLibrary function
+func TestDetectTLSLibrary(t *testing.T) {
+	notElf := filepath.Join(t.TempDir(), "not-elf")
+	if err := os.WriteFile(notElf, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "missing file", path: filepath.Join(t.TempDir(), "missing"), wantErr: true},
+		{name: "not an elf file", path: notElf, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectTLSLibrary(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("detectTLSLibrary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && got != noTLS {
+				t.Errorf("detectTLSLibrary() = %v, want %v", got, noTLS)
+			}
+		})
+	}
+}
+
+// TestTLSHooks tests the hooks attached for every TLS library
+func TestTLSHooks(t *testing.T) {
+	objs := &tarianPrograms{
+		TdfSslE:        &cilium_ebpf.Program{},
+		TdfSslWriteR:   &cilium_ebpf.Program{},
+		TdfSslReadR:    &cilium_ebpf.Program{},
+		TdfGotlsWriteE: &cilium_ebpf.Program{},
+		TdfGotlsReadE:  &cilium_ebpf.Program{},
+		TdfGotlsReadR:  &cilium_ebpf.Program{},
+	}
+
+	tests := []struct {
+		name    string
+		library tlsLibrary
+		want    []string
+	}{
+		{
+			name:    "openssl",
+			library: openSSL,
+			want: []string{
+				"Uprobe//usr/lib/libssl.so.3:SSL_write",
+				"Uretprobe//usr/lib/libssl.so.3:SSL_write",
+				"Uprobe//usr/lib/libssl.so.3:SSL_read",
+				"Uretprobe//usr/lib/libssl.so.3:SSL_read",
+			},
+		},
+		{
+			name:    "go",
+			library: goTLS,
+			want: []string{
+				"Uprobe//usr/lib/libssl.so.3:crypto/tls.(*Conn).Write",
+				"Uprobe//usr/lib/libssl.so.3:crypto/tls.(*Conn).Read",
+				"Uprobe//usr/lib/libssl.so.3:crypto/tls.(*Conn).Read+0x4c",
+				"Uprobe//usr/lib/libssl.so.3:crypto/tls.(*Conn).Read+0x1a4",
+			},
+		},
+		{
+			name:    "none",
+			library: noTLS,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.library.String() != tt.name {
+				t.Errorf("tlsLibrary.String() = %v, want %v", tt.library.String(), tt.name)
+			}
+
+			hooks := tlsHooks(tt.library, "/usr/lib/libssl.so.3", []uint64{0x4c, 0x1a4})
+			if len(hooks) != len(tt.want) {
+				t.Fatalf("tlsHooks() = %v hooks, want %v", len(hooks), len(tt.want))
+			}
+
+			for i, th := range hooks {
+				if th.hook.String() != tt.want[i] {
+					t.Errorf("tlsHooks()[%d] = %v, want %v", i, th.hook.String(), tt.want[i])
+				}
+
+				if th.hook.GetHookType() != ebpf.Uprobe && th.hook.GetHookType() != ebpf.Uretprobe {
+					t.Errorf("tlsHooks()[%d] type = %v, want an uprobe", i, th.hook.GetHookType())
+				}
+
+				if objs.tlsProgram(th.program) == nil {
+					t.Errorf("tlsProgram(%q) = nil, want a program", th.program)
+				}
+			}
+		})
+	}
+}
+
+// TestDecodeReturns tests the decoding of the RET instructions of a function
+func TestDecodeReturns(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine elf.Machine
+		code    []byte
+		want    []uint64
+		wantErr bool
+	}{
+		{
+			name:    "x86_64",
+			machine: elf.EM_X86_64,
+			// mov eax, 0xc3; ret; mov rbx, rax; ret
+			code: []byte{0xb8, 0xc3, 0x00, 0x00, 0x00, 0xc3, 0x48, 0x89, 0xc3, 0xc3},
+			want: []uint64{5, 9},
+		},
+		{
+			name:    "arm64",
+			machine: elf.EM_AARCH64,
+			// nop; ret; padding
+			code: []byte{0x1f, 0x20, 0x03, 0xd5, 0xc0, 0x03, 0x5f, 0xd6, 0x00, 0x00, 0x00, 0x00},
+			want: []uint64{4},
+		},
+		{
+			name:    "no return",
+			machine: elf.EM_AARCH64,
+			code:    []byte{0x1f, 0x20, 0x03, 0xd5},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported machine",
+			machine: elf.EM_RISCV,
+			code:    []byte{0x67, 0x80, 0x00, 0x00},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeReturns(tt.machine, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("decodeReturns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeReturns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestReturnOffsets tests the lookup of the RET instructions of a function of an executable
+func TestReturnOffsets(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// go test strips the symbol table of the test binary unless it is kept with -c or -o
+	if f, err := elf.Open(exe); err == nil {
+		_, err = f.Symbols()
+		f.Close()
+
+		if errors.Is(err, elf.ErrNoSymbols) {
+			t.Skip("the test binary has no symbol table")
+		}
+	}
+
+	got, err := returnOffsets(exe, "testing.tRunner")
+	if err != nil {
+		t.Fatalf("returnOffsets() error = %v", err)
+	}
+
+	if len(got) == 0 || got[0] == 0 {
+		t.Errorf("returnOffsets() = %v, want offsets within the function", got)
+	}
+
+	if _, err := returnOffsets(exe, "missing.symbol"); err == nil {
+		t.Error("returnOffsets() of a missing symbol succeeded")
+	}
+}
+
+// TestTLSMaxData tests the tlsMaxData function
+func TestTLSMaxData(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       uint32
+		want    uint32
+		wantErr bool
+	}{
+		{name: "default", n: 0, want: MaxTLSData},
+		{name: "within bounds", n: 256, want: 256},
+		{name: "above maximum", n: MaxTLSData + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tlsMaxData(tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tlsMaxData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("tlsMaxData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cMacros holds the values of the macros and sizeof expressions used in the cSize expressions,
+// as defined in tarian/c/utils/shared. The macros are expanded textually, as by the C preprocessor.
+var cMacros = map[string]string{
+	"MD_SIZE":              "761", // sizeof(tarian_meta_data_t)
+	"PARAM_SIZE":           "2",   // sizeof(uint16_t)
+	"MAX_STRING_SIZE":      "4096",
+	"MAX_UNIX_SOCKET_PATH": "108 + 1",
+	"MAX_SOCKET_DATA_SIZE": "256",
+	"MAX_TLS_DATA_SIZE":    "4096",
+	"sizeof(uint8_t)":      "1",
+	"sizeof(int32_t)":      "4",
+	"sizeof(uint32_t)":     "4",
+	"sizeof(int64_t)":      "8",
+	"sizeof(uint64_t)":     "8",
+	"sizeof(long)":         "8",
+}
+
+// evalCSize evaluates a cSize expression made of the known macros, integers, additions,
+// multiplications and parentheses.
+func evalCSize(expr string) (uint32, error) {
+	// Longest names first, so that no macro is replaced inside a longer one
+	names := make([]string, 0, len(cMacros))
+	for name := range cMacros {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j]) || len(names[i]) == len(names[j]) && names[i] < names[j]
+	})
+
+	for _, name := range names {
+		expr = strings.ReplaceAll(expr, name, cMacros[name])
+	}
+
+	p := cExprParser{tokens: tokenizeCExpr(expr)}
+	v, err := p.sum()
+	if err != nil {
+		return 0, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return 0, schemaErr.Throwf("unexpected %q in size expression %q", p.tokens[p.pos], expr)
+	}
+
+	return uint32(v), nil
+}
+
+// tokenizeCExpr splits an expression into words, e.g. integers, and single character operators.
+func tokenizeCExpr(expr string) []string {
+	isWord := func(c byte) bool {
+		return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+	}
+
+	var tokens []string
+	for i := 0; i < len(expr); i++ {
+		switch {
+		case expr[i] == ' ':
+		case isWord(expr[i]):
+			j := i
+			for j < len(expr) && isWord(expr[j]) {
+				j++
+			}
+
+			tokens = append(tokens, expr[i:j])
+			i = j - 1
+		default:
+			tokens = append(tokens, expr[i:i+1])
+		}
+	}
+
+	return tokens
+}
+
+// cExprParser is a recursive descent parser of the integer expressions of tokenizeCExpr.
+type cExprParser struct {
+	tokens []string
+	pos    int
+}
+
+// sum parses products separated by +.
+func (p *cExprParser) sum() (uint64, error) {
+	v, err := p.product()
+	for err == nil && p.pos < len(p.tokens) && p.tokens[p.pos] == "+" {
+		p.pos++
+
+		var r uint64
+		r, err = p.product()
+		v += r
+	}
+
+	return v, err
+}
+
+// product parses factors separated by *.
+func (p *cExprParser) product() (uint64, error) {
+	v, err := p.factor()
+	for err == nil && p.pos < len(p.tokens) && p.tokens[p.pos] == "*" {
+		p.pos++
+
+		var r uint64
+		r, err = p.factor()
+		v *= r
+	}
+
+	return v, err
+}
+
+// factor parses an integer or a parenthesized sum.
+func (p *cExprParser) factor() (uint64, error) {
+	if p.pos >= len(p.tokens) {
+		return 0, schemaErr.Throw("unexpected end of size expression")
+	}
+
+	tok := p.tokens[p.pos]
+	p.pos++
+
+	if tok == "(" {
+		v, err := p.sum()
+		if err != nil {
+			return 0, err
+		}
+
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return 0, schemaErr.Throw("missing ) in size expression")
+		}
+
+		p.pos++
+		return v, nil
+	}
+
+	v, err := strconv.ParseUint(tok, 10, 32)
+	if err != nil {
+		return 0, schemaErr.Throwf("unknown %q in size expression", tok)
+	}
+
+	return v, nil
+}
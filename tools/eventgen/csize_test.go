@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"testing"
+)
+
+// Test_evalCSize tests the evalCSize function
+func Test_evalCSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    uint32
+		wantErr bool
+	}{
+		{name: "integer", expr: "12", want: 12},
+		{name: "sum of macros", expr: "MD_SIZE + sizeof(int32_t)", want: 765},
+		{name: "product", expr: "MD_SIZE + MAX_STRING_SIZE*2 + PARAM_SIZE*2", want: 8957},
+		{name: "textual expansion", expr: "MAX_UNIX_SOCKET_PATH*2", want: 110},
+		{name: "parentheses", expr: "(MAX_UNIX_SOCKET_PATH)*2", want: 218},
+		{name: "unknown macro", expr: "MD_SIZE + MAX_PATH", wantErr: true},
+		{name: "unbalanced parentheses", expr: "(MD_SIZE + 1", wantErr: true},
+		{name: "trailing operator", expr: "MD_SIZE +", wantErr: true},
+		{name: "unsupported operator", expr: "MD_SIZE - 1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evalCSize(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("evalCSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("evalCSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
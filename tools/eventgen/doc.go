@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+// Package main provides eventgen, the code generator behind the Tarian event schema.
+//
+// eventgen reads the declarative event schema (tarian/events.json) and emits every
+// artefact that has to agree on the event layout: the TarianEventsE constants and the
+// GenerateTarianEvents table of the eventparser package, the probe registry used by
+// tarian.GetModule and the C header holding the event codes and sizes for the eBPF programs.
+//
+// It is invoked through go generate from the tarian package:
+//
+//	go run ../tools/eventgen -schema events.json -go-events ../pkg/eventparser/events_gen.go -go-programs programs_gen.go -c-header c/utils/shared/events.h
+package main
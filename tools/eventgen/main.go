@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "events.json", "path of the event schema")
+	goEvents := flag.String("go-events", "", "output path of the eventparser definitions")
+	goPrograms := flag.String("go-programs", "", "output path of the tarian probe registry")
+	cHeader := flag.String("c-header", "", "output path of the C event header")
+	flag.Parse()
+
+	schema, err := LoadSchema(*schemaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outputs := []struct {
+		path   string
+		render func(*Schema) ([]byte, error)
+	}{
+		{*goEvents, renderGoEvents},
+		{*goPrograms, renderGoPrograms},
+		{*cHeader, renderCHeader},
+	}
+
+	for _, out := range outputs {
+		if len(out.path) == 0 {
+			continue
+		}
+
+		data, err := out.render(schema)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.WriteFile(out.path, data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"text/template"
+
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var renderErr = err.New("eventgen.render")
+
+const generatedHeader = "Code generated by eventgen from events.json; DO NOT EDIT."
+
+var goEventsTmpl = template.Must(template.New("events").Parse(`// ` + generatedHeader + `
+
+package eventparser
+
+const (
+{{- range .Events}}
+	TDE_SYSCALL_{{.Upper}}_E TarianEventsE = {{.EntryId}} // TDE_SYSCALL_{{.Upper}}_E represents the start of {{.Article}} {{.Name}} syscall
+	TDE_SYSCALL_{{.Upper}}_R TarianEventsE = {{.ExitId}} // TDE_SYSCALL_{{.Upper}}_R represents the return of {{.Article}} {{.Name}} syscall
+{{end -}}
+{{- range .Hooks}}
+	TDE_{{.Upper}} TarianEventsE = {{.Id}} // TDE_{{.Upper}} represents {{.Article}} {{.Name}} event
+{{- end}}
+)
+
+// syscallTable maps the name of every syscall to its number on each supported architecture.
+var syscallTable = map[string]map[string]int{
+{{- range $arch := .Arches}}
+	"{{$arch}}": {
+	{{- range $.Events}}{{if .Supports $arch}}
+		"{{.Name}}": {{index .Syscall $arch}},
+	{{- end}}{{end}}
+	},
+{{- end}}
+}
+
+// GenerateTarianEvents creates and returns a TarianEventMap
+func GenerateTarianEvents() TarianEventMap {
+	events := make(TarianEventMap)
+{{range .Events}}
+	{{.Name}}_e := NewTarianEvent(SyscallId("{{.Name}}"), "sys_{{.Name}}_entry", {{.Entry.Size}},
+	{{- range .Entry.Params}}
+		Param{name: "{{.Name}}", paramType: {{.Type}}, linuxType: "{{.LinuxType}}"{{if .Transform}}, function: {{.Transform}}{{end}}},
+	{{- end}}
+	)
+	events.AddTarianEvent(TDE_SYSCALL_{{.Upper}}_E, {{.Name}}_e)
+
+	{{.Name}}_r := NewTarianEvent(SyscallId("{{.Name}}"), "sys_{{.Name}}_exit", {{.Exit.Size}},
+	{{- range .Exit.Params}}
+		Param{name: "{{.Name}}", paramType: {{.Type}}, linuxType: "{{.LinuxType}}"{{if .Transform}}, function: {{.Transform}}{{end}}},
+	{{- end}}
+	)
+	events.AddTarianEvent(TDE_SYSCALL_{{.Upper}}_R, {{.Name}}_r)
+{{end}}
+{{- range .Hooks}}
+	{{.Name}} := NewTarianEvent(NoSyscall, "{{.Name}}", {{.Event.Size}},
+	{{- range .Event.Params}}
+		Param{name: "{{.Name}}", paramType: {{.Type}}, linuxType: "{{.LinuxType}}"{{if .Transform}}, function: {{.Transform}}{{end}}},
+	{{- end}}
+	)
+	events.AddTarianEvent(TDE_{{.Upper}}, {{.Name}})
+{{end}}
+	return events
+}
+`))
+
+var goProgramsTmpl = template.Must(template.New("programs").Parse(`// ` + generatedHeader + `
+
+package tarian
+
+import "github.com/cilium/ebpf"
+
+// syscallProbes registers the probes of every syscall declared in events.json.
+var syscallProbes = []probeDescriptor{
+{{- range .Events}}
+	{name: "{{.Name}}", arches: []string{ {{- range $i, $a := .SyscallArches}}{{if $i}}, {{end}}"{{$a}}"{{end -}} }, {{if .Optional}}optional: true, {{end}}entry: "tdf_{{.Name}}_e", exit: "tdf_{{.Name}}_r", tpEntry: "tdf_{{.Name}}_te", tpExit: "tdf_{{.Name}}_tr"},
+{{- end}}
+}
+
+// program returns the loaded program with the given name or nil if there is none.
+func (p *tarianPrograms) program(name string) *ebpf.Program {
+	switch name {
+{{- range .Events}}
+	case "tdf_{{.Name}}_e":
+		return p.{{.Program "e"}}
+	case "tdf_{{.Name}}_r":
+		return p.{{.Program "r"}}
+	case "tdf_{{.Name}}_te":
+		return p.{{.Program "te"}}
+	case "tdf_{{.Name}}_tr":
+		return p.{{.Program "tr"}}
+{{- end}}
+	default:
+		return nil
+	}
+}
+`))
+
+var cHeaderTmpl = template.Must(template.New("header").Parse(`// ` + generatedHeader + `
+
+#ifndef __UTLIS_SHARED_EVENTS_H__
+#define __UTLIS_SHARED_EVENTS_H__
+
+typedef enum tarian_events_e{
+{{- range $i, $e := .Events}}
+    {{- if $i}}
+{{end}}
+    // {{.Name}}
+    TDE_SYSCALL_{{.Upper}}_E{{if not $i}} = {{.EntryId}}{{end}},
+    TDE_SYSCALL_{{.Upper}}_R,
+{{- end}}
+{{- range .Hooks}}
+
+    // {{.Name}}
+    TDE_{{.Upper}},
+{{- end}}
+} tarian_event_code;
+{{- with .Hooks}}
+
+// events coded from TDE_FIRST_HOOK on are not raised by syscalls
+#define TDE_FIRST_HOOK TDE_{{(index . 0).Upper}}
+{{- end}}
+
+/*****Event Data Size - START****/
+{{- range .Events}}
+#define TDS_{{.Upper}}_E ({{.Entry.CSize}})
+#define TDS_{{.Upper}}_R ({{.Exit.CSize}})
+{{end -}}
+{{- range .Hooks}}
+#define TDS_{{.Upper}} ({{.Event.CSize}})
+{{end -}}
+/*****Event Data Size - END*****/
+
+#endif
+`))
+
+// renderGoEvents renders the TarianEventsE constants and the GenerateTarianEvents function.
+func renderGoEvents(s *Schema) ([]byte, error) {
+	return renderGo(goEventsTmpl, s)
+}
+
+// renderGoPrograms renders the probe registry of the tarian package.
+func renderGoPrograms(s *Schema) ([]byte, error) {
+	return renderGo(goProgramsTmpl, s)
+}
+
+// renderCHeader renders the C header holding the event codes and sizes.
+func renderCHeader(s *Schema) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := cHeaderTmpl.Execute(&buf, s); err != nil {
+		return nil, renderErr.Throwf("%v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderGo executes the template and formats the result as Go source.
+func renderGo(t *template.Template, s *Schema) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, s); err != nil {
+		return nil, renderErr.Throwf("%v", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, renderErr.Throwf("%s: %v", t.Name(), err)
+	}
+
+	return src, nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRender tests that every output contains the definitions of the schema
+func TestRender(t *testing.T) {
+	s, err := ParseSchema([]byte(validSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		render func(*Schema) ([]byte, error)
+		want   []string
+	}{
+		{
+			name:   "go events",
+			render: renderGoEvents,
+			want: []string{
+				"TDE_SYSCALL_OPENAT2_R TarianEventsE = 5",
+				`NewTarianEvent(SyscallId("openat2"), "sys_openat2_entry", 765,`,
+				"\"arm64\": {\n\t\t\"execve\": 221,\n\t},",
+				`Param{name: "dfd", paramType: TDT_S32, linuxType: "int", function: parseExecveatDird},`,
+				"events.AddTarianEvent(TDE_SYSCALL_EXECVE_R, execve_r)",
+				"TDE_TLS_WRITE TarianEventsE = 6",
+				`NewTarianEvent(NoSyscall, "tls_write", 765,`,
+				"events.AddTarianEvent(TDE_TLS_WRITE, tls_write)",
+			},
+		},
+		{
+			name:   "go programs",
+			render: renderGoPrograms,
+			want: []string{
+				`{name: "execve", arches: []string{"amd64", "arm64"}, entry: "tdf_execve_e", exit: "tdf_execve_r", tpEntry: "tdf_execve_te", tpExit: "tdf_execve_tr"},`,
+				`{name: "openat2", arches: []string{"amd64"}, optional: true, entry: "tdf_openat2_e", exit: "tdf_openat2_r", tpEntry: "tdf_openat2_te", tpExit: "tdf_openat2_tr"},`,
+				"case \"tdf_openat2_r\":\n\t\treturn p.TdfOpenat2R",
+				"case \"tdf_openat2_tr\":\n\t\treturn p.TdfOpenat2Tr",
+			},
+		},
+		{
+			name:   "c header",
+			render: renderCHeader,
+			want: []string{
+				"TDE_SYSCALL_EXECVE_E = 2,",
+				"TDE_SYSCALL_OPENAT2_R,",
+				"#define TDS_OPENAT2_R (MD_SIZE + sizeof(long))",
+				"TDE_TLS_WRITE,",
+				"#define TDE_FIRST_HOOK TDE_TLS_WRITE",
+				"#define TDS_TLS_WRITE (MD_SIZE + sizeof(int32_t))",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.render(s)
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+
+			for _, w := range tt.want {
+				if !strings.Contains(string(got), w) {
+					t.Errorf("render() missing %q in\n%s", w, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/intelops/tarian-detector/pkg/err"
+)
+
+var schemaErr = err.New("eventgen.schema")
+
+// firstEventId is the code assigned to the entry event of the first syscall in the schema.
+// Codes 0 and 1 are reserved by the kernel side.
+const firstEventId = 2
+
+// arches lists the architectures a syscall number can be declared for, named after GOARCH.
+var arches = map[string]bool{
+	"amd64": true,
+	"arm64": true,
+}
+
+// paramTypes lists the Tarian parameter types accepted in the schema.
+var paramTypes = map[string]bool{
+	"TDT_U8":        true,
+	"TDT_U16":       true,
+	"TDT_U32":       true,
+	"TDT_U64":       true,
+	"TDT_S8":        true,
+	"TDT_S16":       true,
+	"TDT_S32":       true,
+	"TDT_S64":       true,
+	"TDT_IPV6":      true,
+	"TDT_STR":       true,
+	"TDT_STR_ARR":   true,
+	"TDT_BYTE_ARR":  true,
+	"TDT_IOVEC_ARR": true,
+	"TDT_SOCKADDR":  true,
+}
+
+var validName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Schema is the declarative description of all the events captured by the detector.
+type Schema struct {
+	Events []Event `json:"events"` // Events in the order their codes are assigned
+	Hooks  []Hook  `json:"hooks"`  // Events captured outside of syscalls, coded after the syscall events
+}
+
+// Event describes a syscall traced through a pair of entry and exit programs.
+type Event struct {
+	Name     string         `json:"name"`               // Name of the syscall, e.g. execve
+	Syscall  map[string]int `json:"syscall"`            // Syscall number on each architecture providing the syscall
+	Optional bool           `json:"optional,omitempty"` // Captured only if enabled when loading the module, for costly syscalls
+	Entry    Probe          `json:"entry"`              // Layout of the entry event
+	Exit     Probe          `json:"exit"`               // Layout of the exit event
+
+	id int // Code of the entry event, the exit event uses id+1
+}
+
+// Hook describes an event sent by programs attached outside of the syscalls, e.g. to uprobes.
+// It has a single code and is reported without syscall number.
+type Hook struct {
+	Name  string `json:"name"`  // Name of the event, e.g. tls_write
+	Event Probe  `json:"event"` // Layout of the event
+
+	id int // Code of the event
+}
+
+// Probe describes the layout of the event sent by one eBPF program.
+type Probe struct {
+	Size   uint32  `json:"size"`   // Maximum size of the event in bytes
+	CSize  string  `json:"cSize"`  // C expression computing the size of the event, which must evaluate to Size
+	Params []Param `json:"params"` // Parameters in the order they are written by the kernel
+}
+
+// Param describes a single parameter of an event.
+type Param struct {
+	Name      string `json:"name"`                // Name of the parameter
+	Type      string `json:"type"`                // Tarian type of the parameter, e.g. TDT_S32
+	LinuxType string `json:"linuxType"`           // Linux type of the parameter
+	Transform string `json:"transform,omitempty"` // Optional eventparser function decoding the value
+}
+
+// LoadSchema reads and validates the schema stored at path.
+func LoadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, schemaErr.Throwf("%v", err)
+	}
+
+	return ParseSchema(data)
+}
+
+// ParseSchema decodes and validates a schema and assigns the event codes.
+func ParseSchema(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, schemaErr.Throwf("%v", err)
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	for i := range s.Events {
+		s.Events[i].id = firstEventId + 2*i
+	}
+
+	for i := range s.Hooks {
+		s.Hooks[i].id = firstEventId + 2*len(s.Events) + i
+	}
+
+	return &s, nil
+}
+
+// validate checks the schema for missing fields, unknown types and duplicate events, including hook events.
+func (s *Schema) validate() error {
+	if len(s.Events) == 0 {
+		return schemaErr.Throw("schema declares no events")
+	}
+
+	seen := make(map[string]bool)
+	for _, e := range s.Events {
+		if !validName.MatchString(e.Name) {
+			return schemaErr.Throwf("invalid event name %q", e.Name)
+		}
+
+		if seen[e.Name] {
+			return schemaErr.Throwf("duplicate event %q", e.Name)
+		}
+		seen[e.Name] = true
+
+		if len(e.Syscall) == 0 {
+			return schemaErr.Throwf("%s: missing syscall number", e.Name)
+		}
+
+		for arch := range e.Syscall {
+			if !arches[arch] {
+				return schemaErr.Throwf("%s: unsupported architecture %q", e.Name, arch)
+			}
+		}
+
+		if err := e.Entry.validate(e.Name, "entry"); err != nil {
+			return err
+		}
+
+		if err := e.Exit.validate(e.Name, "exit"); err != nil {
+			return err
+		}
+	}
+
+	for _, h := range s.Hooks {
+		if !validName.MatchString(h.Name) {
+			return schemaErr.Throwf("invalid hook event name %q", h.Name)
+		}
+
+		if seen[h.Name] {
+			return schemaErr.Throwf("duplicate event %q", h.Name)
+		}
+		seen[h.Name] = true
+
+		if err := h.Event.validate(h.Name, "event"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks the layout of a single probe of the named event.
+func (p *Probe) validate(event, kind string) error {
+	if p.Size == 0 || len(p.CSize) == 0 {
+		return schemaErr.Throwf("%s %s: missing size", event, kind)
+	}
+
+	// The Go side sizes the events with size and the C side with cSize
+	size, err := evalCSize(p.CSize)
+	if err != nil {
+		return schemaErr.Throwf("%s %s: %v", event, kind, err)
+	}
+
+	if size != p.Size {
+		return schemaErr.Throwf("%s %s: size %d disagrees with cSize %q of %d bytes", event, kind, p.Size, p.CSize, size)
+	}
+
+	if len(p.Params) == 0 {
+		return schemaErr.Throwf("%s %s: missing params", event, kind)
+	}
+
+	for _, param := range p.Params {
+		if len(param.Name) == 0 || len(param.LinuxType) == 0 {
+			return schemaErr.Throwf("%s %s: param requires a name and a linuxType", event, kind)
+		}
+
+		if !paramTypes[param.Type] {
+			return schemaErr.Throwf("%s %s: unknown type %q for param %q", event, kind, param.Type, param.Name)
+		}
+	}
+
+	return nil
+}
+
+// Arches returns the supported architectures in a stable order.
+func (s *Schema) Arches() []string {
+	var as []string
+	for arch := range arches {
+		as = append(as, arch)
+	}
+
+	sort.Strings(as)
+	return as
+}
+
+// Supports reports whether the syscall of the event exists on the given architecture.
+func (e Event) Supports(arch string) bool {
+	_, ok := e.Syscall[arch]
+	return ok
+}
+
+// SyscallArches returns the architectures providing the syscall of the event in a stable order.
+func (e Event) SyscallArches() []string {
+	var as []string
+	for arch := range e.Syscall {
+		as = append(as, arch)
+	}
+
+	sort.Strings(as)
+	return as
+}
+
+// Upper returns the name of the event in upper case, as used in the constants.
+func (e Event) Upper() string {
+	return strings.ToUpper(e.Name)
+}
+
+// EntryId returns the code of the entry event.
+func (e Event) EntryId() int {
+	return e.id
+}
+
+// ExitId returns the code of the exit event.
+func (e Event) ExitId() int {
+	return e.id + 1
+}
+
+// Article returns the indefinite article to be used in front of the event name.
+func (e Event) Article() string {
+	if strings.ContainsRune("aeiou", rune(e.Name[0])) {
+		return "an"
+	}
+
+	return "a"
+}
+
+// Program returns the Go identifier generated by bpf2go for the eBPF program
+// of the event with the given suffix (e for entry, r for exit).
+func (e Event) Program(suffix string) string {
+	var b strings.Builder
+	for _, part := range strings.Split("tdf_"+e.Name+"_"+suffix, "_") {
+		if len(part) == 0 {
+			continue
+		}
+
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return b.String()
+}
+
+// Upper returns the name of the hook event in upper case, as used in the constants.
+func (h Hook) Upper() string {
+	return strings.ToUpper(h.Name)
+}
+
+// Id returns the code of the hook event.
+func (h Hook) Id() int {
+	return h.id
+}
+
+// Article returns the indefinite article to be used in front of the hook event name.
+func (h Hook) Article() string {
+	if strings.ContainsRune("aeiou", rune(h.Name[0])) {
+		return "an"
+	}
+
+	return "a"
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2024 Authors of Tarian & the Organization created Tarian
+
+package main
+
+import (
+	"testing"
+)
+
+const validSchema = `{
+  "events": [
+    {
+      "name": "execve",
+      "syscall": {"amd64": 59, "arm64": 221},
+      "entry": {
+        "size": 4857,
+        "cSize": "MD_SIZE + MAX_STRING_SIZE",
+        "params": [{"name": "filename", "type": "TDT_STR", "linuxType": "const char *"}]
+      },
+      "exit": {
+        "size": 765,
+        "cSize": "MD_SIZE + sizeof(int32_t)",
+        "params": [{"name": "return", "type": "TDT_S32", "linuxType": "int"}]
+      }
+    },
+    {
+      "name": "openat2",
+      "syscall": {"amd64": 437},
+      "optional": true,
+      "entry": {
+        "size": 765,
+        "cSize": "MD_SIZE + sizeof(int32_t)",
+        "params": [{"name": "dfd", "type": "TDT_S32", "linuxType": "int", "transform": "parseExecveatDird"}]
+      },
+      "exit": {
+        "size": 769,
+        "cSize": "MD_SIZE + sizeof(long)",
+        "params": [{"name": "return", "type": "TDT_S64", "linuxType": "int"}]
+      }
+    }
+  ],
+  "hooks": [
+    {
+      "name": "tls_write",
+      "event": {
+        "size": 765,
+        "cSize": "MD_SIZE + sizeof(int32_t)",
+        "params": [{"name": "length", "type": "TDT_S32", "linuxType": "int"}]
+      }
+    }
+  ]
+}`
+
+// TestParseSchema tests the ParseSchema function
+func TestParseSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "valid schema",
+			data: validSchema,
+			want: 2,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"events": [`,
+			wantErr: true,
+		},
+		{
+			name:    "no events",
+			data:    `{"events": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			data:    `{"events": [{"name": "Execve"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing syscall",
+			data:    `{"events": [{"name": "execve", "entry": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown architecture",
+			data:    `{"events": [{"name": "execve", "syscall": {"riscv64": 221}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing size",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "size disagreeing with cSize",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 765, "cSize": "MD_SIZE + sizeof(long)", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown macro in cSize",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 765, "cSize": "MD_SIZE + MAX_PATH", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid hook name",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}, "exit": {"size": 1, "cSize": "1", "params": [{"name": "ret", "type": "TDT_S32", "linuxType": "int"}]}}], "hooks": [{"name": "TLS"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate hook name",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}, "exit": {"size": 1, "cSize": "1", "params": [{"name": "ret", "type": "TDT_S32", "linuxType": "int"}]}}], "hooks": [{"name": "execve", "event": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "hook missing params",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S32", "linuxType": "int"}]}, "exit": {"size": 1, "cSize": "1", "params": [{"name": "ret", "type": "TDT_S32", "linuxType": "int"}]}}], "hooks": [{"name": "tls_write", "event": {"size": 1, "cSize": "1"}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			data:    `{"events": [{"name": "execve", "syscall": {"amd64": 59}, "entry": {"size": 1, "cSize": "1", "params": [{"name": "fd", "type": "TDT_S128", "linuxType": "int"}]}}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSchema([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSchema() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err == nil && len(got.Events) != tt.want {
+				t.Errorf("ParseSchema() = %v, want %v", len(got.Events), tt.want)
+			}
+		})
+	}
+}
+
+// TestParseSchema_duplicate tests that an event can only be declared once
+func TestParseSchema_duplicate(t *testing.T) {
+	s, err := ParseSchema([]byte(validSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Events = append(s.Events, s.Events[0])
+	if err := s.validate(); err == nil {
+		t.Errorf("Schema.validate() error = %v, wantErr %v", err, true)
+	}
+}
+
+// TestEvent tests the identifiers derived from an event
+func TestEvent(t *testing.T) {
+	s, err := ParseSchema([]byte(validSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "entry id", got: s.Events[1].EntryId(), want: 4},
+		{name: "exit id", got: s.Events[1].ExitId(), want: 5},
+		{name: "upper", got: s.Events[1].Upper(), want: "OPENAT2"},
+		{name: "article vowel", got: s.Events[0].Article(), want: "an"},
+		{name: "program", got: s.Events[1].Program("e"), want: "TdfOpenat2E"},
+		{name: "supported arch", got: s.Events[0].Supports("arm64"), want: true},
+		{name: "unsupported arch", got: s.Events[1].Supports("arm64"), want: false},
+		{name: "syscall arches", got: len(s.Events[0].SyscallArches()), want: 2},
+		{name: "hook id", got: s.Hooks[0].Id(), want: 6},
+		{name: "hook upper", got: s.Hooks[0].Upper(), want: "TLS_WRITE"},
+		{name: "hook article", got: s.Hooks[0].Article(), want: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Event = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}